@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"errors"
+	"fmt"
 )
 
 // ErrNilPrivateKey is raised when a private key was expected but received nil
@@ -93,3 +94,111 @@ var ErrNilLowLevelSigner = errors.New("nil low level signer")
 
 // ErrFailedAuthentication is returned when a ciphertext could not be decrypted by a given private key
 var ErrFailedAuthentication = errors.New("failed authentication for given ciphertext")
+
+// ErrDuplicatedMessage is raised when an aggregated signature over distinct messages contains the same message twice
+var ErrDuplicatedMessage = errors.New("messages of the aggregated signature are not distinct")
+
+// ErrPoPNotValid is raised when a proof of possession verification fails
+var ErrPoPNotValid = errors.New("proof of possession is invalid")
+
+// ErrNilMultiSigVerifier is raised when a nil multi-signature verifier is provided
+var ErrNilMultiSigVerifier = errors.New("nil multi-signature verifier")
+
+// ErrInvalidBitmap is raised when the signers bitmap does not match the validators set
+var ErrInvalidBitmap = errors.New("signers bitmap is invalid")
+
+// ErrIndexOutOfBounds is raised when an out of bounds index is used
+var ErrIndexOutOfBounds = errors.New("index is out of bounds")
+
+// ErrNotEnoughSigners is raised when the number of signers is below the required threshold
+var ErrNotEnoughSigners = errors.New("not enough signers")
+
+// ErrNotEnoughStake is raised when the stake of the signers is below the required threshold
+var ErrNotEnoughStake = errors.New("not enough stake")
+
+// ErrPubKeyAlreadyAggregated is raised when the signature share of a public key is aggregated twice
+var ErrPubKeyAlreadyAggregated = errors.New("public key is already aggregated")
+
+// ErrPubKeyNotAggregated is raised when removing the signature share of a public key that was not aggregated
+var ErrPubKeyNotAggregated = errors.New("public key is not aggregated")
+
+// ErrInvalidThreshold is raised when the threshold of a t-of-n scheme is not in the [1, n] interval
+var ErrInvalidThreshold = errors.New("threshold is invalid")
+
+// ErrInvalidShareIndex is raised when a share index is not in the [1, n] interval
+var ErrInvalidShareIndex = errors.New("share index is invalid")
+
+// ErrDuplicatedShareIndex is raised when the same share index is provided twice
+var ErrDuplicatedShareIndex = errors.New("duplicated share index")
+
+// ErrNotEnoughShares is raised when there are less shares than the threshold
+var ErrNotEnoughShares = errors.New("not enough shares")
+
+// ErrNilDKGTransport is raised when a nil distributed key generation transport is provided
+var ErrNilDKGTransport = errors.New("nil distributed key generation transport")
+
+// ErrParticipantNotFound is raised when the own identity key is not part of the participants list
+var ErrParticipantNotFound = errors.New("participant not found")
+
+// ErrInvalidDKGMessage is raised when a distributed key generation message is malformed
+var ErrInvalidDKGMessage = errors.New("distributed key generation message is invalid")
+
+// ErrWrongDKGPhase is raised when a distributed key generation step is called out of order
+var ErrWrongDKGPhase = errors.New("wrong distributed key generation phase")
+
+// ErrNilSessionID is raised when an empty distributed key generation session ID is provided
+var ErrNilSessionID = errors.New("nil session ID")
+
+// ErrNotEnoughQualifiedDealers is raised when less dealers than the threshold remain qualified after a distributed key generation
+var ErrNotEnoughQualifiedDealers = errors.New("not enough qualified dealers")
+
+// ErrInvalidDomainSeparationTag is raised when a hash to curve domain separation tag is empty or longer than 255 bytes
+var ErrInvalidDomainSeparationTag = errors.New("domain separation tag is invalid")
+
+// ErrInvalidKeyMaterial is raised when the input key material of a key derivation is too short
+var ErrInvalidKeyMaterial = errors.New("input key material is invalid")
+
+// ErrInvalidCiphersuite is raised when an unknown BLS signature ciphersuite is provided
+var ErrInvalidCiphersuite = errors.New("ciphersuite is invalid")
+
+// ErrNotSupportedByCiphersuite is raised when an operation is not defined for the configured ciphersuite
+var ErrNotSupportedByCiphersuite = errors.New("operation is not supported by the ciphersuite")
+
+// ErrNilThresholdSigner is raised when a nil threshold signer is provided
+var ErrNilThresholdSigner = errors.New("nil threshold signer")
+
+// ErrNilBeaconEntry is raised when a nil randomness beacon entry is provided
+var ErrNilBeaconEntry = errors.New("nil beacon entry")
+
+// ErrInvalidBeaconEntry is raised when a randomness beacon entry does not follow its previous entry
+var ErrInvalidBeaconEntry = errors.New("beacon entry is invalid")
+
+// ErrInvalidWeight is raised when a signer weight is zero or when the total weight overflows
+var ErrInvalidWeight = errors.New("weight is invalid")
+
+// ErrPairingLinesCacheFull is raised when a public key is registered in a pairing lines cache that is full of public
+// keys used in the current or in the previous epoch
+var ErrPairingLinesCacheFull = errors.New("pairing lines cache is full")
+
+// ErrNilPairingLinesCache is raised when a pairing lines cache is required but not set
+var ErrNilPairingLinesCache = errors.New("nil pairing lines cache")
+
+// ErrNilPublicKeysCache is raised when a nil public keys cache is provided
+var ErrNilPublicKeysCache = errors.New("nil public keys cache")
+
+// InvalidSharesError is raised when an aggregated signature is invalid and the invalid signature shares that caused
+// it were identified. It wraps ErrAggSigNotValid
+type InvalidSharesError struct {
+	// Indexes are the positions of the invalid shares in the list of signatures, in increasing order
+	Indexes []int
+}
+
+// Error returns the error message, including the indexes of the invalid shares
+func (e *InvalidSharesError) Error() string {
+	return fmt.Sprintf("%s, invalid shares at indexes %v", ErrAggSigNotValid.Error(), e.Indexes)
+}
+
+// Unwrap returns ErrAggSigNotValid, so that errors.Is(err, ErrAggSigNotValid) holds for this error
+func (e *InvalidSharesError) Unwrap() error {
+	return ErrAggSigNotValid
+}
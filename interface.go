@@ -210,6 +210,152 @@ type LowLevelSignerBLS interface {
 	IsInterfaceNil() bool
 }
 
+// LowLevelAggregateSignerBLS provides functionality to aggregate BLS signatures over distinct messages and to verify
+// the aggregated signature against the (public key, message) pairs of the signers
+type LowLevelAggregateSignerBLS interface {
+	// SignShare creates a BLS single signature over a given message
+	SignShare(privKey PrivateKey, message []byte) ([]byte, error)
+	// VerifySigShare verifies a BLS single signature
+	VerifySigShare(pubKey PublicKey, message []byte, sig []byte) error
+	// VerifySigBytes verifies if a byte array represents a BLS signature
+	VerifySigBytes(suite Suite, sig []byte) error
+	// AggregateSignatures aggregates BLS single signatures given as byte arrays
+	AggregateSignatures(suite Suite, signatures [][]byte) ([]byte, error)
+	// AggregateVerify verifies an aggregated signature where each message was signed by the public key on the same position
+	AggregateVerify(suite Suite, pubKeys []PublicKey, messages [][]byte, aggSigBytes []byte) error
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// AggregateSigner provides functionality for aggregating signatures over distinct messages and verifying them
+type AggregateSigner interface {
+	// CreateSignatureShare creates a partial signature
+	CreateSignatureShare(privateKeyBytes []byte, message []byte) ([]byte, error)
+	// VerifySignatureShare verifies the partial signature of the signer over the given message
+	VerifySignatureShare(publicKey []byte, message []byte, sig []byte) error
+	// AggregateSigs aggregates all collected partial signatures
+	AggregateSigs(signatures [][]byte) ([]byte, error)
+	// AggregateVerify verifies the aggregated signature, where messages[i] was signed by pubKeysSigners[i]
+	AggregateVerify(pubKeysSigners [][]byte, messages [][]byte, aggSig []byte) error
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// LowLevelPoPSignerBLS provides functionality to create and verify proofs of possession for BLS secret keys
+type LowLevelPoPSignerBLS interface {
+	// CreatePoP creates the proof of possession for the given private key
+	CreatePoP(privKey PrivateKey) ([]byte, error)
+	// VerifyPoP verifies the proof of possession of the secret key corresponding to the given public key
+	VerifyPoP(pubKey PublicKey, pop []byte) error
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// PoPHandler provides functionality for creating and verifying proofs of possession for keys given as byte arrays
+type PoPHandler interface {
+	// CreatePoP creates the proof of possession for the given private key
+	CreatePoP(privateKeyBytes []byte) ([]byte, error)
+	// VerifyPoP verifies the proof of possession of the secret key corresponding to the given public key
+	VerifyPoP(publicKey []byte, pop []byte) error
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// LowLevelPubKeysAggregatorBLS provides functionality to aggregate BLS public keys once and to verify aggregated
+// signatures against the aggregated public key
+type LowLevelPubKeysAggregatorBLS interface {
+	// AggregatePublicKeys aggregates the public keys into one public key
+	AggregatePublicKeys(suite Suite, pubKeys []PublicKey) (PublicKey, error)
+	// VerifyAggregatedSigWithAggregatedPubKey verifies the validity of an aggregated signature against an aggregated public key
+	VerifyAggregatedSigWithAggregatedPubKey(suite Suite, aggPubKey PublicKey, aggSigBytes []byte, msg []byte) error
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// LowLevelWeightedSignerBLS provides functionality to aggregate BLS signatures scaled by the integer weights of their
+// signers and to verify them, reporting the total weight of the signers
+type LowLevelWeightedSignerBLS interface {
+	// AggregateWeightedSignatures aggregates BLS single signatures, each multiplied by the weight of its signer
+	AggregateWeightedSignatures(suite Suite, signatures [][]byte, pubKeysSigners []PublicKey, weights []uint64) ([]byte, error)
+	// VerifyWeightedAggregatedSig verifies a weighted aggregated signature over a given message and returns the total weight
+	VerifyWeightedAggregatedSig(suite Suite, pubKeys []PublicKey, weights []uint64, aggSigBytes []byte, msg []byte) (uint64, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// WeightedMultiSigner provides functionality for aggregating signature shares weighted by the stake of their
+// signers and verifying them against the public keys and weights of the signers
+type WeightedMultiSigner interface {
+	// AggregateWeightedSigs aggregates the partial signatures, each one scaled by the weight of its signer
+	AggregateWeightedSigs(pubKeysSigners [][]byte, signatures [][]byte, weights []uint64) ([]byte, error)
+	// VerifyWeightedAggregatedSig verifies the weighted aggregated signature and returns the total weight of the signers
+	VerifyWeightedAggregatedSig(pubKeysSigners [][]byte, weights []uint64, message []byte, aggSig []byte) (uint64, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// LowLevelFaultAttributionSignerBLS provides functionality to aggregate BLS signature shares and verify the result in
+// one step, identifying the invalid shares when the aggregated signature is not valid
+type LowLevelFaultAttributionSignerBLS interface {
+	// AggregateAndVerifySignatures aggregates the signature shares and verifies the aggregated signature over the
+	// message. If it is not valid, the returned error is an *InvalidSharesError holding the indexes of the invalid shares
+	AggregateAndVerifySignatures(suite Suite, signatures [][]byte, pubKeysSigners []PublicKey, msg []byte) ([]byte, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// FaultAttributionMultiSigner provides functionality for aggregating signature shares optimistically, without
+// verifying them one by one, and for identifying the signers of the invalid shares when the aggregation is not valid
+type FaultAttributionMultiSigner interface {
+	// AggregateAndVerifySigs aggregates the signature shares and verifies the aggregated signature, returning an
+	// *InvalidSharesError with the indexes of the invalid shares if it is not valid
+	AggregateAndVerifySigs(pubKeysSigners [][]byte, signatures [][]byte, message []byte) ([]byte, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// PubKeysAggregator provides functionality for aggregating public keys given as byte arrays and
+// verifying multi-signatures against the aggregated public key
+type PubKeysAggregator interface {
+	// AggregatePubKeys aggregates the public keys of the signers into one public key
+	AggregatePubKeys(pubKeysSigners [][]byte) ([]byte, error)
+	// VerifyAggregatedSigWithAggPubKey verifies the aggregated signature against the aggregated public key
+	VerifyAggregatedSigWithAggPubKey(aggPubKey []byte, message []byte, aggSig []byte) error
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// IncrementalAggregator keeps a running aggregation of signature shares and of the matching public keys
+type IncrementalAggregator interface {
+	// Add adds the signature share of the given public key to the aggregation
+	Add(pubKey []byte, sigShare []byte) error
+	// Remove removes the signature share of the given public key from the aggregation
+	Remove(pubKey []byte) error
+	// Has returns true if the signature share of the given public key is part of the aggregation
+	Has(pubKey []byte) bool
+	// NumShares returns the number of aggregated signature shares
+	NumShares() int
+	// AggregatedSig returns the aggregated signature of the current shares
+	AggregatedSig() ([]byte, error)
+	// AggregatedPubKey returns the aggregated public key of the current shares
+	AggregatedPubKey() ([]byte, error)
+	// Reset removes all the shares from the aggregation
+	Reset()
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
+// DKGTransport is the communication layer used by the participants of a distributed key generation protocol
+type DKGTransport interface {
+	// Broadcast sends the message on the given topic to all the other participants
+	Broadcast(topic string, message []byte) error
+	// Receive returns the messages received on the given topic from the other participants. It blocks until
+	// all the participants sent their message or until the deadline of the topic passes
+	Receive(topic string) ([][]byte, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
+
 // PeerSignatureHandler is a wrapper over SingleSigner that buffers the peer signatures.
 // When it needs to sign or to verify a signature, it searches the buffer first.
 type PeerSignatureHandler interface {
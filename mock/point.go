@@ -50,7 +50,9 @@ func (po *PointMock) Set(_ crypto.Point) error {
 
 // Clone returns a clone of the receiver.
 func (po *PointMock) Clone() crypto.Point {
-	panic("implement me")
+	pp := *po
+
+	return &pp
 }
 
 // Add returns the result of adding receiver with Point p given as parameter,
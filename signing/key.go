@@ -20,13 +20,15 @@ type privateKey struct {
 
 // publicKey holds the public key and the chosen curve
 type publicKey struct {
-	suite crypto.Suite
-	pk    crypto.Point
+	suite     crypto.Suite
+	pk        crypto.Point
+	validated bool
 }
 
 // keyGenerator generates private and public keys
 type keyGenerator struct {
-	suite crypto.Suite
+	suite        crypto.Suite
+	pubKeysCache *PublicKeysCache
 }
 
 // NewKeyGenerator returns a new key generator with the given curve suite
@@ -34,6 +36,22 @@ func NewKeyGenerator(suite crypto.Suite) *keyGenerator {
 	return &keyGenerator{suite: suite}
 }
 
+// NewKeyGeneratorWithPublicKeysCache returns a new key generator with the given curve suite, that validates the public
+// keys decoded from byte arrays and keeps the valid ones in the given cache
+func NewKeyGeneratorWithPublicKeysCache(suite crypto.Suite, pubKeysCache *PublicKeysCache) (*keyGenerator, error) {
+	if check.IfNil(suite) {
+		return nil, crypto.ErrNilSuite
+	}
+	if check.IfNil(pubKeysCache) {
+		return nil, crypto.ErrNilPublicKeysCache
+	}
+
+	return &keyGenerator{
+		suite:        suite,
+		pubKeysCache: pubKeysCache,
+	}, nil
+}
+
 // GeneratePair will generate a bundle of private and public key
 func (kg *keyGenerator) GeneratePair() (crypto.PrivateKey, crypto.PublicKey) {
 	private, public, err := newKeyPair(kg.suite)
@@ -74,16 +92,35 @@ func (kg *keyGenerator) PublicKeyFromByteArray(b []byte) (crypto.PublicKey, erro
 		return nil, crypto.ErrInvalidParam
 	}
 
+	if kg.pubKeysCache != nil {
+		pubKey, found := kg.pubKeysCache.Get(b)
+		if found {
+			return pubKey, nil
+		}
+	}
+
 	point := kg.suite.CreatePoint()
 	err := point.UnmarshalBinary(b)
 	if err != nil {
 		return nil, err
 	}
 
-	return &publicKey{
+	pubKey := &publicKey{
 		suite: kg.suite,
 		pk:    point,
-	}, nil
+	}
+	if kg.pubKeysCache == nil {
+		return pubKey, nil
+	}
+
+	// only the valid public keys are cached, marked as validated so that the signers do not check them again. The
+	// invalid ones are returned as without a cache, and are rejected by the signers
+	if kg.suite.CheckPointValid(b) == nil {
+		pubKey.validated = true
+		kg.pubKeysCache.Put(b, pubKey)
+	}
+
+	return pubKey, nil
 }
 
 // CheckPublicKeyValid verifies the validity of the public key
@@ -101,6 +138,15 @@ func (kg *keyGenerator) IsInterfaceNil() bool {
 	return kg == nil
 }
 
+// IsValidatedPublicKey returns true if the point of the public key was checked to be valid when the public key was
+// decoded, as for the public keys taken from a public keys cache. Only the public keys created by the key generators
+// of this package can be marked as validated, the other implementations are never trusted
+func IsValidatedPublicKey(pubKey crypto.PublicKey) bool {
+	pk, ok := pubKey.(*publicKey)
+
+	return ok && pk.validated
+}
+
 func newKeyPair(suite crypto.Suite) (private crypto.Scalar, public crypto.Point, err error) {
 	if check.IfNil(suite) {
 		return nil, nil, crypto.ErrNilSuite
@@ -299,3 +299,80 @@ func TestPublicKey_SuiteOK(t *testing.T) {
 
 	assert.Equal(t, suite, s2)
 }
+
+func TestNewKeyGeneratorWithPublicKeysCache(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := signing.NewPublicKeysCache(10)
+
+	kg, err := signing.NewKeyGeneratorWithPublicKeysCache(nil, cache)
+	assert.Nil(t, kg)
+	assert.Equal(t, crypto.ErrNilSuite, err)
+
+	kg, err = signing.NewKeyGeneratorWithPublicKeysCache(&mock.SuiteMock{}, nil)
+	assert.Nil(t, kg)
+	assert.Equal(t, crypto.ErrNilPublicKeysCache, err)
+
+	kg, err = signing.NewKeyGeneratorWithPublicKeysCache(&mock.SuiteMock{}, cache)
+	assert.Nil(t, err)
+	assert.NotNil(t, kg)
+}
+
+func TestKeyGenerator_PublicKeyFromByteArrayWithCacheShouldDecodeOnce(t *testing.T) {
+	t.Parallel()
+
+	numDecoded := 0
+	suite := createMockSuite()
+	suite.CreatePointStub = func() crypto.Point {
+		numDecoded++
+		return createPoint()
+	}
+	pubKeyBytes := []byte("valid key 1")
+	suite.PointLenStub = func() int {
+		return len(pubKeyBytes)
+	}
+	cache, _ := signing.NewPublicKeysCache(10)
+	kg, _ := signing.NewKeyGeneratorWithPublicKeysCache(suite, cache)
+
+	pubKey, err := kg.PublicKeyFromByteArray(pubKeyBytes)
+	assert.Nil(t, err)
+	cachedPubKey, err := kg.PublicKeyFromByteArray(pubKeyBytes)
+	assert.Nil(t, err)
+	assert.False(t, pubKey == cachedPubKey)
+	samePoint, _ := pubKey.Point().Equal(cachedPubKey.Point())
+	assert.True(t, samePoint)
+	assert.True(t, signing.IsValidatedPublicKey(cachedPubKey))
+	assert.Equal(t, 1, numDecoded)
+
+	_, err = kg.PublicKeyFromByteArray(invalidStr)
+	assert.NotNil(t, err)
+	_, err = kg.PublicKeyFromByteArray(invalidStr)
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, numDecoded)
+	assert.Equal(t, 1, cache.Len())
+	assert.Equal(t, uint64(1), cache.Metrics().Hits)
+}
+
+func TestKeyGenerator_PublicKeyFromByteArrayWithCacheInvalidPointShouldNotCache(t *testing.T) {
+	t.Parallel()
+
+	pubKeyBytes := []byte("invalid point")
+	suite := createMockSuite()
+	suite.PointLenStub = func() int {
+		return len(pubKeyBytes)
+	}
+	suite.IsPointValidStub = func(_ []byte) error {
+		return crypto.ErrInvalidPoint
+	}
+	cache, _ := signing.NewPublicKeysCache(10)
+	kg, _ := signing.NewKeyGeneratorWithPublicKeysCache(suite, cache)
+
+	pubKey, err := kg.PublicKeyFromByteArray(pubKeyBytes)
+	assert.Nil(t, err)
+	assert.False(t, signing.IsValidatedPublicKey(pubKey))
+	assert.Equal(t, 0, cache.Len())
+
+	pubKey, err = signing.NewKeyGenerator(suite).PublicKeyFromByteArray(pubKeyBytes)
+	assert.Nil(t, err)
+	assert.False(t, signing.IsValidatedPublicKey(pubKey))
+}
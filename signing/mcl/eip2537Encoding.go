@@ -0,0 +1,119 @@
+package mcl
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+/*
+The EIP-2537 encoding is the input and output layout of the BLS12-381 precompiles of Ethereum:
+https://eips.ethereum.org/EIPS/eip-2537
+
+The points are always uncompressed and the field elements are written in big endian order on 64 bytes, the 16 most
+significant bytes being 0. For Fp2 elements, unlike the ZCash serialization, the real part comes first. There are no
+flags, the point at infinity is encoded as all zero bytes.
+*/
+
+const (
+	// EIP2537G1Len is the length of a G1 point in the EIP-2537 encoding
+	EIP2537G1Len = 128
+	// EIP2537G2Len is the length of a G2 point in the EIP-2537 encoding
+	EIP2537G2Len = 256
+
+	eip2537FpLen     = 64
+	eip2537FpPadding = eip2537FpLen - fpByteLen
+)
+
+// G1ToEIP2537 encodes the G1 point in the EIP-2537 layout
+func G1ToEIP2537(point *bls.G1) []byte {
+	result := make([]byte, EIP2537G1Len)
+	if point.IsZero() {
+		return result
+	}
+
+	affine := &bls.G1{}
+	bls.G1Normalize(affine, point)
+
+	copy(result[eip2537FpPadding:], fpToBigEndian(&affine.X))
+	copy(result[eip2537FpLen+eip2537FpPadding:], fpToBigEndian(&affine.Y))
+
+	return result
+}
+
+// G1FromEIP2537 decodes a G1 point from the EIP-2537 layout. The point is checked to be on the curve and in the G1
+// subgroup, as the precompiles do for the multi scalar multiplication and the pairing inputs
+func G1FromEIP2537(data []byte) (*bls.G1, error) {
+	if len(data) != EIP2537G1Len {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	point := &bls.G1{}
+	if isAllZero(data) {
+		point.Clear()
+		return point, nil
+	}
+
+	err := fpFromEIP2537(&point.X, data[:eip2537FpLen])
+	if err != nil {
+		return nil, err
+	}
+	err = fpFromEIP2537(&point.Y, data[eip2537FpLen:])
+	if err != nil {
+		return nil, err
+	}
+	point.Z.SetInt64(1)
+
+	return validateG1(point)
+}
+
+// G2ToEIP2537 encodes the G2 point in the EIP-2537 layout
+func G2ToEIP2537(point *bls.G2) []byte {
+	result := make([]byte, EIP2537G2Len)
+	if point.IsZero() {
+		return result
+	}
+
+	affine := &bls.G2{}
+	bls.G2Normalize(affine, point)
+
+	coordinates := []*bls.Fp{&affine.X.D[0], &affine.X.D[1], &affine.Y.D[0], &affine.Y.D[1]}
+	for i, coordinate := range coordinates {
+		copy(result[i*eip2537FpLen+eip2537FpPadding:], fpToBigEndian(coordinate))
+	}
+
+	return result
+}
+
+// G2FromEIP2537 decodes a G2 point from the EIP-2537 layout. The point is checked to be on the curve and in the G2
+// subgroup, as the precompiles do for the multi scalar multiplication and the pairing inputs
+func G2FromEIP2537(data []byte) (*bls.G2, error) {
+	if len(data) != EIP2537G2Len {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	point := &bls.G2{}
+	if isAllZero(data) {
+		point.Clear()
+		return point, nil
+	}
+
+	coordinates := []*bls.Fp{&point.X.D[0], &point.X.D[1], &point.Y.D[0], &point.Y.D[1]}
+	for i, coordinate := range coordinates {
+		err := fpFromEIP2537(coordinate, data[i*eip2537FpLen:(i+1)*eip2537FpLen])
+		if err != nil {
+			return nil, err
+		}
+	}
+	point.Z.D[0].SetInt64(1)
+
+	return validateG2(point)
+}
+
+// fpFromEIP2537 sets the field element from its padded big endian representation
+func fpFromEIP2537(fp *bls.Fp, data []byte) error {
+	if !isAllZero(data[:eip2537FpPadding]) {
+		return crypto.ErrInvalidPoint
+	}
+
+	return fpFromBigEndian(fp, data[eip2537FpPadding:])
+}
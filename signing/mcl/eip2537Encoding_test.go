@@ -0,0 +1,106 @@
+package mcl
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestG1EIP2537(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generator should be padded", func(t *testing.T) {
+		encoded := G1ToEIP2537(NewPointG1().G1)
+		uncompressed := G1ToZCashUncompressed(NewPointG1().G1)
+
+		require.Equal(t, EIP2537G1Len, len(encoded))
+		require.Equal(t, make([]byte, 16), encoded[:16])
+		require.Equal(t, uncompressed[:48], encoded[16:64])
+		require.Equal(t, make([]byte, 16), encoded[64:80])
+		require.Equal(t, uncompressed[48:], encoded[80:])
+	})
+	t.Run("wrong length should err", func(t *testing.T) {
+		point, err := G1FromEIP2537(make([]byte, EIP2537G1Len-1))
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("non zero padding should err", func(t *testing.T) {
+		data := G1ToEIP2537(NewPointG1().G1)
+		data[0] = 1
+
+		point, err := G1FromEIP2537(data)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("point not on curve should err", func(t *testing.T) {
+		data := G1ToEIP2537(NewPointG1().G1)
+		data[EIP2537G1Len-1] ^= 0x01
+
+		point, err := G1FromEIP2537(data)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("point at infinity should be all zero", func(t *testing.T) {
+		zero := &bls.G1{}
+		zero.Clear()
+		require.Equal(t, make([]byte, EIP2537G1Len), G1ToEIP2537(zero))
+
+		point, err := G1FromEIP2537(make([]byte, EIP2537G1Len))
+		require.Nil(t, err)
+		require.True(t, point.IsZero())
+	})
+	t.Run("round trip should work", func(t *testing.T) {
+		_, point := NewSuiteBLS12MinPubKey().CreateKeyPair()
+		g1 := point.(*PointG1).G1
+
+		decoded, err := G1FromEIP2537(G1ToEIP2537(g1))
+		require.Nil(t, err)
+		require.True(t, decoded.IsEqual(g1))
+	})
+}
+
+func TestG2EIP2537(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generator should have the real parts first", func(t *testing.T) {
+		encoded := G2ToEIP2537(NewStandardGeneratorG2())
+		uncompressed := G2ToZCashUncompressed(NewStandardGeneratorG2())
+
+		require.Equal(t, EIP2537G2Len, len(encoded))
+		expected := make([]byte, 0, EIP2537G2Len)
+		for _, i := range []int{1, 0, 3, 2} {
+			expected = append(expected, make([]byte, 16)...)
+			expected = append(expected, uncompressed[i*48:(i+1)*48]...)
+		}
+		require.True(t, bytes.Equal(expected, encoded))
+	})
+	t.Run("wrong length should err", func(t *testing.T) {
+		point, err := G2FromEIP2537(make([]byte, EIP2537G1Len))
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("non zero padding should err", func(t *testing.T) {
+		data := G2ToEIP2537(NewStandardGeneratorG2())
+		data[128] = 1
+
+		point, err := G2FromEIP2537(data)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("point at infinity should be all zero", func(t *testing.T) {
+		point, err := G2FromEIP2537(make([]byte, EIP2537G2Len))
+		require.Nil(t, err)
+		require.True(t, point.IsZero())
+	})
+	t.Run("round trip should work", func(t *testing.T) {
+		_, point := NewSuiteBLS12().CreateKeyPair()
+		g2 := point.(*PointG2).G2
+
+		decoded, err := G2FromEIP2537(G2ToEIP2537(g2))
+		require.Nil(t, err)
+		require.True(t, decoded.IsEqual(g2))
+	})
+}
@@ -0,0 +1,248 @@
+package mcl
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createEdgeScalars returns the scalars at the limits of the recoding: 0, 1, 2, r-2, r-1, and powers of two
+func createEdgeScalars() []*Scalar {
+	scalars := make([]*Scalar, 0)
+	for _, value := range []int64{0, 1, 2, 3, 15, 16, 17, -1, -2} {
+		scalar := NewScalar()
+		scalar.Scalar.SetInt64(value)
+		scalars = append(scalars, scalar)
+	}
+
+	for _, exponent := range []int{63, 64, 128, 252, 254} {
+		scalar := NewScalar()
+		scalar.Scalar.SetInt64(1)
+		two := NewScalar()
+		two.Scalar.SetInt64(2)
+		for i := 0; i < exponent; i++ {
+			bls.FrMul(scalar.Scalar, scalar.Scalar, two.Scalar)
+		}
+		scalars = append(scalars, scalar)
+	}
+
+	for i := 0; i < 50; i++ {
+		scalars = append(scalars, NewScalar())
+	}
+
+	return scalars
+}
+
+func TestNewFixedBaseTableG1(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil base should err", func(t *testing.T) {
+		fbt, err := NewFixedBaseTableG1(nil)
+		assert.Nil(t, fbt)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		fbt, err = NewFixedBaseTableG1(&PointG1{})
+		assert.Nil(t, fbt)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("identity base should err", func(t *testing.T) {
+		fbt, err := NewFixedBaseTableG1(NewPointG1().Null().(*PointG1))
+		assert.Nil(t, fbt)
+		assert.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		base, _ := NewPointG1().Pick()
+		fbt, err := NewFixedBaseTableG1(base.(*PointG1))
+		require.Nil(t, err)
+
+		eq, _ := fbt.Base().Equal(base)
+		require.True(t, eq)
+	})
+}
+
+func TestFixedBaseTableG1_Mul(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil scalar should err", func(t *testing.T) {
+		result, err := GeneratorTableG1().Mul(nil)
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		result, err = GeneratorTableG1().Mul(&Scalar{})
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("should equal the generic multiplication", func(t *testing.T) {
+		base, _ := NewPointG1().Pick()
+		fbt, _ := NewFixedBaseTableG1(base.(*PointG1))
+
+		for _, table := range []*FixedBaseTableG1{GeneratorTableG1(), fbt} {
+			for _, scalar := range createEdgeScalars() {
+				expected, _ := table.Base().Mul(scalar)
+
+				result, err := table.Mul(scalar)
+				require.Nil(t, err)
+
+				eq, _ := result.Equal(expected)
+				require.True(t, eq, scalar.Scalar.GetString(10))
+			}
+		}
+	})
+	t.Run("group should use the generator table", func(t *testing.T) {
+		scalar := NewScalar()
+		expected, _ := NewPointG1().Mul(scalar)
+
+		eq, _ := (&groupG1{}).CreatePointForScalar(scalar).Equal(expected)
+		require.True(t, eq)
+	})
+}
+
+func TestNewFixedBaseTableG2(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil base should err", func(t *testing.T) {
+		fbt, err := NewFixedBaseTableG2(nil)
+		assert.Nil(t, fbt)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		fbt, err = NewFixedBaseTableG2(&PointG2{})
+		assert.Nil(t, fbt)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("identity base should err", func(t *testing.T) {
+		fbt, err := NewFixedBaseTableG2(NewPointG2().Null().(*PointG2))
+		assert.Nil(t, fbt)
+		assert.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		base, _ := NewPointG2().Pick()
+		fbt, err := NewFixedBaseTableG2(base.(*PointG2))
+		require.Nil(t, err)
+
+		eq, _ := fbt.Base().Equal(base)
+		require.True(t, eq)
+	})
+}
+
+func TestFixedBaseTableG2_Mul(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil scalar should err", func(t *testing.T) {
+		result, err := GeneratorTableG2().Mul(nil)
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		result, err = GeneratorTableG2().Mul(&Scalar{})
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("should equal the generic multiplication", func(t *testing.T) {
+		base, _ := NewPointG2().Pick()
+		fbt, _ := NewFixedBaseTableG2(base.(*PointG2))
+
+		for _, table := range []*FixedBaseTableG2{GeneratorTableG2(), fbt} {
+			for _, scalar := range createEdgeScalars() {
+				expected, _ := table.Base().Mul(scalar)
+
+				result, err := table.Mul(scalar)
+				require.Nil(t, err)
+
+				eq, _ := result.Equal(expected)
+				require.True(t, eq, scalar.Scalar.GetString(10))
+			}
+		}
+	})
+	t.Run("suite should use the generator table", func(t *testing.T) {
+		suite := NewSuiteBLS12()
+		privKey, pubKey := suite.CreateKeyPair()
+		expected, _ := NewPointG2().Mul(privKey)
+
+		eq, _ := pubKey.Equal(expected)
+		require.True(t, eq)
+
+		point, err := suite.CreatePointForScalar(privKey)
+		require.Nil(t, err)
+		eq, _ = point.Equal(expected)
+		require.True(t, eq)
+	})
+}
+
+func TestRecodeFixedBaseScalar(t *testing.T) {
+	t.Parallel()
+
+	for _, scalar := range createEdgeScalars() {
+		digits, negateResult := recodeFixedBaseScalar(scalar.Scalar)
+
+		// sum(d_i*2^(w*i)), computed with Horner's rule from the most significant digit
+		sum := NewScalar().Zero().(*Scalar)
+		radix := NewScalar()
+		radix.Scalar.SetInt64(1 << fixedBaseWindow)
+		digit := NewScalar()
+		for i := len(digits) - 1; i >= 0; i-- {
+			require.Equal(t, int64(1), digits[i]&1)
+			require.Less(t, digits[i], int64(1<<fixedBaseWindow))
+			require.Greater(t, digits[i], -int64(1<<fixedBaseWindow))
+
+			digit.Scalar.SetInt64(digits[i])
+			_ = sum.MulAssign(radix)
+			_ = sum.AddAssign(digit)
+		}
+
+		if negateResult != 0 {
+			bls.FrNeg(sum.Scalar, sum.Scalar)
+		}
+		require.True(t, sum.Scalar.IsEqual(scalar.Scalar), scalar.Scalar.GetString(10))
+	}
+}
+
+func BenchmarkFixedBaseTableG1_Mul(b *testing.B) {
+	scalar := NewScalar()
+	table := GeneratorTableG1()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = table.Mul(scalar)
+	}
+}
+
+func BenchmarkPointG1_MulGenerator(b *testing.B) {
+	scalar := NewScalar()
+	generator := NewPointG1()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = generator.Mul(scalar)
+	}
+}
+
+func BenchmarkFixedBaseTableG2_Mul(b *testing.B) {
+	scalar := NewScalar()
+	table := GeneratorTableG2()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = table.Mul(scalar)
+	}
+}
+
+func BenchmarkPointG2_MulGenerator(b *testing.B) {
+	scalar := NewScalar()
+	generator := NewPointG2()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = generator.Mul(scalar)
+	}
+}
+
+func BenchmarkNewFixedBaseTableG2(b *testing.B) {
+	base := NewPointG2()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = NewFixedBaseTableG2(base)
+	}
+}
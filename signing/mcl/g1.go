@@ -33,8 +33,18 @@ func (g1 *groupG1) CreatePoint() crypto.Point {
 	return NewPointG1()
 }
 
-// CreatePointForScalar creates a new point corresponding to the given scalarInt
+// CreatePointForScalar creates a new point corresponding to the given scalarInt, using the precomputed table of
+// multiples of the generator
 func (g1 *groupG1) CreatePointForScalar(scalar crypto.Scalar) crypto.Point {
+	sc, ok := scalar.(*Scalar)
+	if ok && sc != nil && sc.Scalar != nil {
+		p, err := GeneratorTableG1().Mul(sc)
+		if err != nil {
+			log.Error("groupG1 CreatePointForScalar", "error", err.Error())
+		}
+		return p
+	}
+
 	var p crypto.Point
 	var err error
 	p = NewPointG1()
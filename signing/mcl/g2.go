@@ -33,8 +33,18 @@ func (g2 *groupG2) CreatePoint() crypto.Point {
 	return NewPointG2()
 }
 
-// CreatePointForScalar creates a new point corresponding to the given scalarInt
+// CreatePointForScalar creates a new point corresponding to the given scalarInt, using the precomputed table of
+// multiples of the generator
 func (g2 *groupG2) CreatePointForScalar(scalar crypto.Scalar) crypto.Point {
+	sc, ok := scalar.(*Scalar)
+	if ok && sc != nil && sc.Scalar != nil {
+		p, err := GeneratorTableG2().Mul(sc)
+		if err != nil {
+			log.Error("groupG2 CreatePointForScalar", "error", err.Error())
+		}
+		return p
+	}
+
 	var p crypto.Point
 	var err error
 	p = NewPointG2()
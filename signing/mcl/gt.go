@@ -1,10 +1,17 @@
 package mcl
 
 import (
+	"sync"
+
 	"github.com/herumi/bls-go-binary/bls"
 	"github.com/multiversx/mx-chain-crypto-go"
 )
 
+var (
+	generatorGTPoint *PointGT
+	generatorGTOnce  sync.Once
+)
+
 type groupGT struct {
 }
 
@@ -33,9 +40,28 @@ func (gt *groupGT) CreatePoint() crypto.Point {
 	return NewPointGT()
 }
 
-// CreatePointForScalar creates a new point corresponding to the given scalarInt
-func (gt *groupGT) CreatePointForScalar(_ crypto.Scalar) crypto.Point {
-	panic("not supported")
+// CreatePointForScalar creates a new point corresponding to the given scalarInt, as the power of the generator of GT,
+// the pairing of the generators of G1 and G2
+func (gt *groupGT) CreatePointForScalar(scalar crypto.Scalar) crypto.Point {
+	var p crypto.Point
+	var err error
+	p = generatorGT()
+	p, err = p.Mul(scalar)
+	if err != nil {
+		log.Error("groupGT CreatePointForScalar", "error", err.Error())
+	}
+	return p
+}
+
+// generatorGT returns a copy of e(g1, g2), the generator of GT for the generators of G1 and G2 used by the suites. The
+// pairing is computed on first use
+func generatorGT() *PointGT {
+	generatorGTOnce.Do(func() {
+		generatorGTPoint = NewPointGT()
+		bls.Pairing(generatorGTPoint.GT, NewPointG1().G1, NewPointG2().G2)
+	})
+
+	return generatorGTPoint.Clone().(*PointGT)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
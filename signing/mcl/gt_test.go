@@ -67,13 +67,6 @@ func TestGroupGT_CreateScalar(t *testing.T) {
 func TestGroupGT_CreatePointForScalar(t *testing.T) {
 	t.Parallel()
 
-	defer func() {
-		r := recover()
-		if r == nil {
-			assert.Fail(t, "should panic as currently not supported")
-		}
-	}()
-
 	grGT := &groupGT{}
 
 	scalar := grGT.CreateScalar()
@@ -83,7 +76,32 @@ func TestGroupGT_CreatePointForScalar(t *testing.T) {
 	require.False(t, mclScalar.IsOne())
 	require.True(t, mclScalar.IsValid())
 
-	_ = grGT.CreatePointForScalar(scalar)
+	point := grGT.CreatePointForScalar(scalar)
+	pointGT, ok := point.(*PointGT)
+	require.True(t, ok)
+
+	pointG1 := &bls.G1{}
+	bls.G1Mul(pointG1, NewPointG1().G1, mclScalar)
+	expected := &bls.GT{}
+	bls.Pairing(expected, pointG1, NewPointG2().G2)
+	assert.True(t, expected.IsEqual(pointGT.GT))
+
+	point = grGT.CreatePointForScalar(nil)
+	assert.Nil(t, point)
+}
+
+func TestGeneratorGT(t *testing.T) {
+	t.Parallel()
+
+	expected := &bls.GT{}
+	bls.Pairing(expected, NewPointG1().G1, NewPointG2().G2)
+
+	generator := generatorGT()
+	assert.True(t, expected.IsEqual(generator.GT))
+
+	// the returned generator is a copy, so modifying it does not change the next ones
+	bls.GTMul(generator.GT, generator.GT, generator.GT)
+	assert.True(t, expected.IsEqual(generatorGT().GT))
 }
 
 func TestGroupGT_IsInterfaceNil(t *testing.T) {
@@ -0,0 +1,389 @@
+package mcl
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+/*
+Hash to curve for BLS12-381, as defined in RFC 9380 for the BLS12381G1_XMD:SHA-256_SSWU_RO_ and
+BLS12381G2_XMD:SHA-256_SSWU_RO_ suites:
+
+	hash_to_curve(msg) = clear_cofactor(map_to_curve(u0) + map_to_curve(u1)), where (u0, u1) = hash_to_field(msg, 2)
+
+hash_to_field expands the message with expand_message_xmd (SHA-256) and the given domain separation tag, and
+map_to_curve is the simplified SWU map to a curve isogenous to the target one, followed by the isogeny map.
+
+The herumi library also implements this mapping, but the mode and the domain separation tags are global settings
+of the library, so they cannot differ between signers and changing them would change the default message mapping
+used by the existing signatures. The mapping is therefore done here, over the field arithmetic of the library.
+The computation is not constant time, which is fine as only public data (messages and public keys) is hashed.
+*/
+
+const (
+	// HashToFieldLen is the number of bytes expanded for every field element in hash_to_field
+	HashToFieldLen = 64
+	// MaxDSTLen is the maximum length of a domain separation tag
+	MaxDSTLen         = 255
+	sha256BlockSize   = 64
+	maxExpandedBlocks = 255
+)
+
+type hashToCurveParams struct {
+	aG1    bls.Fp
+	bG1    bls.Fp
+	zG1    bls.Fp
+	isoG1  [4][]bls.Fp
+	hEffG1 *big.Int
+	aG2    bls.Fp2
+	bG2    bls.Fp2
+	zG2    bls.Fp2
+	isoG2  [4][]bls.Fp2
+	hEffG2 *big.Int
+}
+
+var (
+	htcParams     *hashToCurveParams
+	htcParamsOnce sync.Once
+)
+
+func getHashToCurveParams() *hashToCurveParams {
+	htcParamsOnce.Do(func() {
+		htcParams = createHashToCurveParams()
+	})
+
+	return htcParams
+}
+
+func createHashToCurveParams() *hashToCurveParams {
+	params := &hashToCurveParams{}
+
+	mustSetFp(&params.aG1, sswuAG1)
+	mustSetFp(&params.bG1, sswuBG1)
+	params.zG1.SetInt64(sswuZG1)
+	for i, coefficients := range isogenyG1 {
+		params.isoG1[i] = make([]bls.Fp, len(coefficients))
+		for j, coefficient := range coefficients {
+			mustSetFp(&params.isoG1[i][j], coefficient)
+		}
+	}
+	params.hEffG1, _ = big.NewInt(0).SetString(hEffG1, 16)
+
+	// A' = 240*I, B' = 1012*(1+I), Z = -(2+I)
+	params.aG2.D[1].SetInt64(240)
+	params.bG2.D[0].SetInt64(1012)
+	params.bG2.D[1].SetInt64(1012)
+	params.zG2.D[0].SetInt64(-2)
+	params.zG2.D[1].SetInt64(-1)
+	for i, coefficients := range isogenyG2 {
+		params.isoG2[i] = make([]bls.Fp2, len(coefficients))
+		for j, coefficient := range coefficients {
+			mustSetFp(&params.isoG2[i][j].D[0], coefficient[0])
+			mustSetFp(&params.isoG2[i][j].D[1], coefficient[1])
+		}
+	}
+	params.hEffG2, _ = big.NewInt(0).SetString(hEffG2, 16)
+
+	return params
+}
+
+func mustSetFp(fp *bls.Fp, hexValue string) {
+	err := fp.SetString(hexValue, 16)
+	if err != nil {
+		panic(fmt.Sprintf("invalid hash to curve constant %s: %v", hexValue, err))
+	}
+}
+
+// HashToG1 hashes the message to a point on G1 with the BLS12381G1_XMD:SHA-256_SSWU_RO_ suite of RFC 9380,
+// using the given domain separation tag
+func HashToG1(msg []byte, dst []byte) (*bls.G1, error) {
+	expanded, err := ExpandMessageXMD(msg, dst, 2*HashToFieldLen)
+	if err != nil {
+		return nil, err
+	}
+
+	params := getHashToCurveParams()
+	result := &bls.G1{}
+	result.Clear()
+	for i := 0; i < 2; i++ {
+		u := &bls.Fp{}
+		err = u.SetBigEndianMod(expanded[i*HashToFieldLen : (i+1)*HashToFieldLen])
+		if err != nil {
+			return nil, err
+		}
+
+		bls.G1Add(result, result, params.mapToCurveG1(u))
+	}
+
+	return params.clearCofactorG1(result), nil
+}
+
+// HashToG2 hashes the message to a point on G2 with the BLS12381G2_XMD:SHA-256_SSWU_RO_ suite of RFC 9380,
+// using the given domain separation tag
+func HashToG2(msg []byte, dst []byte) (*bls.G2, error) {
+	expanded, err := ExpandMessageXMD(msg, dst, 4*HashToFieldLen)
+	if err != nil {
+		return nil, err
+	}
+
+	params := getHashToCurveParams()
+	result := &bls.G2{}
+	result.Clear()
+	for i := 0; i < 2; i++ {
+		u := &bls.Fp2{}
+		for j := 0; j < 2; j++ {
+			offset := (2*i + j) * HashToFieldLen
+			err = u.D[j].SetBigEndianMod(expanded[offset : offset+HashToFieldLen])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		bls.G2Add(result, result, params.mapToCurveG2(u))
+	}
+
+	return params.clearCofactorG2(result), nil
+}
+
+// mapToCurveG1 applies the simplified SWU map to E1' followed by the 11-isogeny map to E1
+func (params *hashToCurveParams) mapToCurveG1(u *bls.Fp) *bls.G1 {
+	a, b, z := &params.aG1, &params.bG1, &params.zG1
+
+	// tv1 = 1 / (Z^2 * u^4 + Z * u^2)
+	zu2 := &bls.Fp{}
+	bls.FpSqr(zu2, u)
+	bls.FpMul(zu2, zu2, z)
+	tv1 := &bls.Fp{}
+	bls.FpSqr(tv1, zu2)
+	bls.FpAdd(tv1, tv1, zu2)
+
+	x1 := &bls.Fp{}
+	if tv1.IsZero() {
+		// x1 = B / (Z * A)
+		bls.FpMul(x1, z, a)
+		bls.FpDiv(x1, b, x1)
+	} else {
+		// x1 = (-B / A) * (1 + 1 / tv1)
+		bls.FpInv(tv1, tv1)
+		one := &bls.Fp{}
+		one.SetInt64(1)
+		bls.FpAdd(tv1, tv1, one)
+		bls.FpDiv(x1, b, a)
+		bls.FpNeg(x1, x1)
+		bls.FpMul(x1, x1, tv1)
+	}
+
+	x, y := &bls.Fp{}, &bls.Fp{}
+	*x = *x1
+	if !bls.FpSquareRoot(y, curveEquationG1(x1, a, b)) {
+		// x2 = Z * u^2 * x1
+		bls.FpMul(x, zu2, x1)
+		bls.FpSquareRoot(y, curveEquationG1(x, a, b))
+	}
+	if u.IsOdd() != y.IsOdd() {
+		bls.FpNeg(y, y)
+	}
+
+	return params.isogenyMapG1(x, y)
+}
+
+// curveEquationG1 returns x^3 + A * x + B
+func curveEquationG1(x *bls.Fp, a *bls.Fp, b *bls.Fp) *bls.Fp {
+	result := &bls.Fp{}
+	bls.FpSqr(result, x)
+	bls.FpAdd(result, result, a)
+	bls.FpMul(result, result, x)
+	bls.FpAdd(result, result, b)
+
+	return result
+}
+
+func (params *hashToCurveParams) isogenyMapG1(x *bls.Fp, y *bls.Fp) *bls.G1 {
+	var values [4]bls.Fp
+	for i, coefficients := range params.isoG1 {
+		// Horner's method, starting from the highest power of x
+		values[i] = coefficients[len(coefficients)-1]
+		for j := len(coefficients) - 2; j >= 0; j-- {
+			bls.FpMul(&values[i], &values[i], x)
+			bls.FpAdd(&values[i], &values[i], &coefficients[j])
+		}
+	}
+
+	result := &bls.G1{}
+	result.Clear()
+	xNum, xDen, yNum, yDen := &values[0], &values[1], &values[2], &values[3]
+	if xDen.IsZero() || yDen.IsZero() {
+		return result
+	}
+
+	bls.FpDiv(&result.X, xNum, xDen)
+	bls.FpDiv(&result.Y, yNum, yDen)
+	bls.FpMul(&result.Y, &result.Y, y)
+	result.Z.SetInt64(1)
+
+	return result
+}
+
+// clearCofactorG1 multiplies the point with the effective cofactor. The point is not in the G1 subgroup yet,
+// so the multiplication is done with plain double and add instead of the library one, which relies on the
+// endomorphism of the subgroup
+func (params *hashToCurveParams) clearCofactorG1(point *bls.G1) *bls.G1 {
+	result := &bls.G1{}
+	result.Clear()
+	for i := params.hEffG1.BitLen() - 1; i >= 0; i-- {
+		bls.G1Dbl(result, result)
+		if params.hEffG1.Bit(i) == 1 {
+			bls.G1Add(result, result, point)
+		}
+	}
+
+	return result
+}
+
+// mapToCurveG2 applies the simplified SWU map to E2' followed by the 3-isogeny map to E2
+func (params *hashToCurveParams) mapToCurveG2(u *bls.Fp2) *bls.G2 {
+	a, b, z := &params.aG2, &params.bG2, &params.zG2
+
+	// tv1 = 1 / (Z^2 * u^4 + Z * u^2)
+	zu2 := &bls.Fp2{}
+	bls.Fp2Sqr(zu2, u)
+	bls.Fp2Mul(zu2, zu2, z)
+	tv1 := &bls.Fp2{}
+	bls.Fp2Sqr(tv1, zu2)
+	bls.Fp2Add(tv1, tv1, zu2)
+
+	x1 := &bls.Fp2{}
+	if tv1.IsZero() {
+		// x1 = B / (Z * A)
+		bls.Fp2Mul(x1, z, a)
+		bls.Fp2Div(x1, b, x1)
+	} else {
+		// x1 = (-B / A) * (1 + 1 / tv1)
+		bls.Fp2Inv(tv1, tv1)
+		one := &bls.Fp2{}
+		one.D[0].SetInt64(1)
+		bls.Fp2Add(tv1, tv1, one)
+		bls.Fp2Div(x1, b, a)
+		bls.Fp2Neg(x1, x1)
+		bls.Fp2Mul(x1, x1, tv1)
+	}
+
+	x, y := &bls.Fp2{}, &bls.Fp2{}
+	*x = *x1
+	if !bls.Fp2SquareRoot(y, curveEquationG2(x1, a, b)) {
+		// x2 = Z * u^2 * x1
+		bls.Fp2Mul(x, zu2, x1)
+		bls.Fp2SquareRoot(y, curveEquationG2(x, a, b))
+	}
+	if sgn0Fp2(u) != sgn0Fp2(y) {
+		bls.Fp2Neg(y, y)
+	}
+
+	return params.isogenyMapG2(x, y)
+}
+
+// curveEquationG2 returns x^3 + A * x + B
+func curveEquationG2(x *bls.Fp2, a *bls.Fp2, b *bls.Fp2) *bls.Fp2 {
+	result := &bls.Fp2{}
+	bls.Fp2Sqr(result, x)
+	bls.Fp2Add(result, result, a)
+	bls.Fp2Mul(result, result, x)
+	bls.Fp2Add(result, result, b)
+
+	return result
+}
+
+// sgn0Fp2 implements the sgn0 function of RFC 9380 for the elements of Fp2
+func sgn0Fp2(x *bls.Fp2) bool {
+	return x.D[0].IsOdd() || (x.D[0].IsZero() && x.D[1].IsOdd())
+}
+
+func (params *hashToCurveParams) isogenyMapG2(x *bls.Fp2, y *bls.Fp2) *bls.G2 {
+	var values [4]bls.Fp2
+	for i, coefficients := range params.isoG2 {
+		// Horner's method, starting from the highest power of x
+		values[i] = coefficients[len(coefficients)-1]
+		for j := len(coefficients) - 2; j >= 0; j-- {
+			bls.Fp2Mul(&values[i], &values[i], x)
+			bls.Fp2Add(&values[i], &values[i], &coefficients[j])
+		}
+	}
+
+	result := &bls.G2{}
+	result.Clear()
+	xNum, xDen, yNum, yDen := &values[0], &values[1], &values[2], &values[3]
+	if xDen.IsZero() || yDen.IsZero() {
+		return result
+	}
+
+	bls.Fp2Div(&result.X, xNum, xDen)
+	bls.Fp2Div(&result.Y, yNum, yDen)
+	bls.Fp2Mul(&result.Y, &result.Y, y)
+	result.Z.D[0].SetInt64(1)
+
+	return result
+}
+
+// clearCofactorG2 multiplies the point with the effective cofactor, with plain double and add as for G1
+func (params *hashToCurveParams) clearCofactorG2(point *bls.G2) *bls.G2 {
+	result := &bls.G2{}
+	result.Clear()
+	for i := params.hEffG2.BitLen() - 1; i >= 0; i-- {
+		bls.G2Dbl(result, result)
+		if params.hEffG2.Bit(i) == 1 {
+			bls.G2Add(result, result, point)
+		}
+	}
+
+	return result
+}
+
+// ExpandMessageXMD implements expand_message_xmd from RFC 9380 with SHA-256
+func ExpandMessageXMD(msg []byte, dst []byte, lenInBytes int) ([]byte, error) {
+	if len(dst) == 0 || len(dst) > MaxDSTLen {
+		return nil, crypto.ErrInvalidDomainSeparationTag
+	}
+
+	ell := (lenInBytes + sha256.Size - 1) / sha256.Size
+	if lenInBytes <= 0 || ell > maxExpandedBlocks {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sha256BlockSize))
+	h.Write(msg)
+	h.Write([]byte{byte(lenInBytes >> 8), byte(lenInBytes), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	result := make([]byte, 0, ell*sha256.Size)
+	result = append(result, bi...)
+	for i := 2; i <= ell; i++ {
+		xored := make([]byte, sha256.Size)
+		for j := range xored {
+			xored[j] = b0[j] ^ bi[j]
+		}
+
+		h.Reset()
+		h.Write(xored)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		result = append(result, bi...)
+	}
+
+	return result[:lenInBytes], nil
+}
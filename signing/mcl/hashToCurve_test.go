@@ -0,0 +1,136 @@
+package mcl
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/require"
+)
+
+// test vectors from RFC 9380, appendix J.9.1 and J.10.1
+var hashToCurveTestVectors = []struct {
+	msg string
+	g1  string
+	g2  string
+}{
+	{
+		msg: "",
+		g1: "1 52926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1" +
+			" 8ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265",
+		g2: "1 141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a" +
+			" 5cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d" +
+			" 503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92" +
+			" 12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6",
+	},
+	{
+		msg: "abc",
+		g1: "1 3567bc5ef9c690c2ab2ecdf6a96ef1c139cc0b2f284dca0a9a7943388a49a3aee664ba5379a7655d3c68900be2f6903" +
+			" b9c15f3fe6e5cf4211f346271d7b01c8f3b28be689c8429c85b67af215533311f0b8dfaaa154fa6b88176c229f2885d",
+		g2: "1 2c2d18e033b960562aae3cab37a27ce00d80ccd5ba4b7fe0e7a210245129dbec7780ccc7954725f4168aff2787776e6" +
+			" 139cddbccdc5e91b9623efd38c49f81a6f83f175e80b06fc374de9eb4b41dfe4ca3a230ed250fbe3a2acf73a41177fd8" +
+			" 1787327b68159716a37440985269cf584bcb1e621d3a7202be6ea05c4cfe244aeb197642555a0645fb87bf7466b2ba48" +
+			" aa65dae3c8d732d10ecd2c50f8a1baf3001578f71c694e03866e9f3d49ac1e1ce70dd94a733534f106d4cec0eddd16",
+	},
+}
+
+func TestExpandMessageXMD(t *testing.T) {
+	t.Parallel()
+
+	// test vectors from RFC 9380, appendix K.1
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+
+	t.Run("invalid params should err", func(t *testing.T) {
+		res, err := ExpandMessageXMD([]byte("abc"), nil, 32)
+		require.Equal(t, crypto.ErrInvalidDomainSeparationTag, err)
+		require.Nil(t, res)
+
+		res, err = ExpandMessageXMD([]byte("abc"), make([]byte, MaxDSTLen+1), 32)
+		require.Equal(t, crypto.ErrInvalidDomainSeparationTag, err)
+		require.Nil(t, res)
+
+		res, err = ExpandMessageXMD([]byte("abc"), dst, 0)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+		require.Nil(t, res)
+
+		res, err = ExpandMessageXMD([]byte("abc"), dst, 256*32)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+		require.Nil(t, res)
+	})
+	t.Run("empty message", func(t *testing.T) {
+		res, err := ExpandMessageXMD([]byte(""), dst, 0x20)
+		require.Nil(t, err)
+		require.Equal(t, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235", hex.EncodeToString(res))
+	})
+	t.Run("abc message", func(t *testing.T) {
+		res, err := ExpandMessageXMD([]byte("abc"), dst, 0x20)
+		require.Nil(t, err)
+		require.Equal(t, "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615", hex.EncodeToString(res))
+	})
+}
+
+func TestHashToG1(t *testing.T) {
+	t.Parallel()
+
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_")
+
+	t.Run("invalid dst should err", func(t *testing.T) {
+		point, err := HashToG1([]byte("abc"), nil)
+		require.Equal(t, crypto.ErrInvalidDomainSeparationTag, err)
+		require.Nil(t, point)
+	})
+	t.Run("should match the RFC test vectors", func(t *testing.T) {
+		for _, vector := range hashToCurveTestVectors {
+			point, err := HashToG1([]byte(vector.msg), dst)
+			require.Nil(t, err)
+			require.Equal(t, vector.g1, point.GetString(16))
+			require.True(t, point.IsValidOrder())
+		}
+	})
+	t.Run("different dst should give different points", func(t *testing.T) {
+		point1, _ := HashToG1([]byte("abc"), dst)
+		point2, _ := HashToG1([]byte("abc"), []byte("OTHER-DST"))
+		require.False(t, point1.IsEqual(point2))
+	})
+}
+
+func TestHashToG2(t *testing.T) {
+	t.Parallel()
+
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+
+	t.Run("invalid dst should err", func(t *testing.T) {
+		point, err := HashToG2([]byte("abc"), make([]byte, MaxDSTLen+1))
+		require.Equal(t, crypto.ErrInvalidDomainSeparationTag, err)
+		require.Nil(t, point)
+	})
+	t.Run("should match the RFC test vectors", func(t *testing.T) {
+		for _, vector := range hashToCurveTestVectors {
+			point, err := HashToG2([]byte(vector.msg), dst)
+			require.Nil(t, err)
+			require.Equal(t, vector.g2, point.GetString(16))
+			require.True(t, point.IsValidOrder())
+		}
+	})
+	t.Run("different dst should give different points", func(t *testing.T) {
+		point1, _ := HashToG2([]byte("abc"), dst)
+		point2, _ := HashToG2([]byte("abc"), []byte("OTHER-DST"))
+		require.False(t, point1.IsEqual(point2))
+	})
+}
+
+func TestHashToCurveParams_MapToCurveExceptionalCase(t *testing.T) {
+	t.Parallel()
+
+	// u = 0 is the input for which the denominator of the simplified SWU map is zero
+	params := getHashToCurveParams()
+
+	pointG1 := params.clearCofactorG1(params.mapToCurveG1(&bls.Fp{}))
+	require.False(t, pointG1.IsZero())
+	require.True(t, pointG1.IsValidOrder())
+
+	pointG2 := params.clearCofactorG2(params.mapToCurveG2(&bls.Fp2{}))
+	require.False(t, pointG2.IsZero())
+	require.True(t, pointG2.IsValidOrder())
+}
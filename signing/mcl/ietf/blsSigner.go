@@ -0,0 +1,216 @@
+package ietf
+
+import (
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+/*
+This package implements the BLS signatures as specified by the IETF draft, so the keys and the signatures are
+interoperable with the other implementations of the draft, like the ones of the Ethereum consensus layer tooling:
+https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature
+
+It differs from the other signers of the repository in the following ways:
+- the points are serialized in the ZCash compressed format instead of the herumi one
+- the generator of G2 is the one of the BLS12-381 specification instead of the herumi one
+- the messages are hashed to the curve with RFC 9380 and the domain separation tag of the ciphersuite
+- the secret keys are derived with KeyGen and serialized as 32 bytes big endian integers
+
+As the keys of the signing.KeyGenerator use the herumi generator of G2, the signer works on serialized keys rather than
+on crypto.PrivateKey and crypto.PublicKey. Empty messages are valid, as in the draft.
+*/
+
+// BlsSigner signs and verifies BLS signatures with one of the ciphersuites of the BLS signature draft
+type BlsSigner struct {
+	ciphersuite Ciphersuite
+	groups      variantGroups
+	sigDST      []byte
+	popDST      []byte
+}
+
+// NewBlsSigner creates a BLS signer for the given ciphersuite
+func NewBlsSigner(ciphersuite Ciphersuite) (*BlsSigner, error) {
+	if !ciphersuite.isValid() {
+		return nil, crypto.ErrInvalidCiphersuite
+	}
+
+	var groups variantGroups = newMinSignatureGroups()
+	if ciphersuite.Variant == MinimalPublicKeySize {
+		groups = newMinPublicKeyGroups()
+	}
+
+	return &BlsSigner{
+		ciphersuite: ciphersuite,
+		groups:      groups,
+		sigDST:      []byte(ciphersuite.ID()),
+		popDST:      []byte(ciphersuite.popID()),
+	}, nil
+}
+
+// CiphersuiteID returns the identifier of the configured ciphersuite
+func (bs *BlsSigner) CiphersuiteID() string {
+	return bs.ciphersuite.ID()
+}
+
+// SkToPk returns the serialized public key of the serialized secret key
+func (bs *BlsSigner) SkToPk(sk []byte) ([]byte, error) {
+	scalar, err := secretKeyFromBytes(sk)
+	if err != nil {
+		return nil, err
+	}
+
+	return bs.groups.skToPk(scalar), nil
+}
+
+// KeyValidate checks that the public key is a valid point of its group and that it is not the identity
+func (bs *BlsSigner) KeyValidate(pk []byte) error {
+	return bs.groups.keyValidate(pk)
+}
+
+// Sign signs the message with the serialized secret key
+func (bs *BlsSigner) Sign(sk []byte, msg []byte) ([]byte, error) {
+	scalar, err := secretKeyFromBytes(sk)
+	if err != nil {
+		return nil, err
+	}
+
+	if bs.ciphersuite.Scheme == MessageAugmentation {
+		msg = augmentMessage(bs.groups.skToPk(scalar), msg)
+	}
+
+	return bs.groups.coreSign(scalar, msg, bs.sigDST)
+}
+
+// Verify verifies the signature of the message against the serialized public key
+func (bs *BlsSigner) Verify(pk []byte, msg []byte, sig []byte) error {
+	if bs.ciphersuite.Scheme == MessageAugmentation {
+		msg = augmentMessage(pk, msg)
+	}
+
+	isValid, err := bs.groups.coreAggregateVerify([][]byte{pk}, [][]byte{msg}, sig, bs.sigDST)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return crypto.ErrSigNotValid
+	}
+
+	return nil
+}
+
+// Aggregate aggregates the signatures into one signature
+func (bs *BlsSigner) Aggregate(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, crypto.ErrNilSignaturesList
+	}
+
+	return bs.groups.aggregateSignatures(sigs)
+}
+
+// AggregateVerify verifies the aggregated signature of the messages, the message i being signed by the public key i.
+// With the basic scheme the messages have to be distinct
+func (bs *BlsSigner) AggregateVerify(pks [][]byte, msgs [][]byte, sig []byte) error {
+	if len(pks) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+	if len(pks) != len(msgs) {
+		return crypto.ErrInvalidParam
+	}
+
+	signedMsgs := make([][]byte, len(msgs))
+	seenMsgs := make(map[string]struct{}, len(msgs))
+	for i, msg := range msgs {
+		switch bs.ciphersuite.Scheme {
+		case Basic:
+			_, found := seenMsgs[string(msg)]
+			if found {
+				return crypto.ErrDuplicatedMessage
+			}
+			seenMsgs[string(msg)] = struct{}{}
+			signedMsgs[i] = msg
+		case MessageAugmentation:
+			signedMsgs[i] = augmentMessage(pks[i], msg)
+		default:
+			signedMsgs[i] = msg
+		}
+	}
+
+	isValid, err := bs.groups.coreAggregateVerify(pks, signedMsgs, sig, bs.sigDST)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return crypto.ErrAggSigNotValid
+	}
+
+	return nil
+}
+
+// FastAggregateVerify verifies the aggregated signature of the same message by all the public keys. It is only
+// available with the proof of possession scheme, and the proofs of the public keys have to be verified beforehand
+func (bs *BlsSigner) FastAggregateVerify(pks [][]byte, msg []byte, sig []byte) error {
+	if bs.ciphersuite.Scheme != ProofOfPossession {
+		return crypto.ErrNotSupportedByCiphersuite
+	}
+	if len(pks) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+
+	aggregatedPk, err := bs.groups.aggregatePublicKeys(pks)
+	if err != nil {
+		return err
+	}
+
+	isValid, err := bs.groups.coreAggregateVerify([][]byte{aggregatedPk}, [][]byte{msg}, sig, bs.sigDST)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return crypto.ErrAggSigNotValid
+	}
+
+	return nil
+}
+
+// PopProve creates the proof of possession of the serialized secret key
+func (bs *BlsSigner) PopProve(sk []byte) ([]byte, error) {
+	if bs.ciphersuite.Scheme != ProofOfPossession {
+		return nil, crypto.ErrNotSupportedByCiphersuite
+	}
+
+	scalar, err := secretKeyFromBytes(sk)
+	if err != nil {
+		return nil, err
+	}
+
+	return bs.groups.coreSign(scalar, bs.groups.skToPk(scalar), bs.popDST)
+}
+
+// PopVerify verifies the proof of possession of the secret key of the serialized public key
+func (bs *BlsSigner) PopVerify(pk []byte, proof []byte) error {
+	if bs.ciphersuite.Scheme != ProofOfPossession {
+		return crypto.ErrNotSupportedByCiphersuite
+	}
+
+	isValid, err := bs.groups.coreAggregateVerify([][]byte{pk}, [][]byte{pk}, proof, bs.popDST)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return crypto.ErrPoPNotValid
+	}
+
+	return nil
+}
+
+// augmentMessage returns the concatenation of the serialized public key with the message
+func augmentMessage(pk []byte, msg []byte) []byte {
+	augmented := make([]byte, 0, len(pk)+len(msg))
+	augmented = append(augmented, pk...)
+
+	return append(augmented, msg...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bs *BlsSigner) IsInterfaceNil() bool {
+	return bs == nil
+}
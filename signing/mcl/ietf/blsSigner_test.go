@@ -0,0 +1,483 @@
+package ietf_test
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/ietf"
+	"github.com/stretchr/testify/require"
+)
+
+const testMessage = "message to be signed"
+
+var allCiphersuites = []ietf.Ciphersuite{
+	{Variant: ietf.MinimalSignatureSize, Scheme: ietf.Basic},
+	{Variant: ietf.MinimalSignatureSize, Scheme: ietf.MessageAugmentation},
+	{Variant: ietf.MinimalSignatureSize, Scheme: ietf.ProofOfPossession},
+	{Variant: ietf.MinimalPublicKeySize, Scheme: ietf.Basic},
+	{Variant: ietf.MinimalPublicKeySize, Scheme: ietf.MessageAugmentation},
+	{Variant: ietf.MinimalPublicKeySize, Scheme: ietf.ProofOfPossession},
+}
+
+func createKeys(t *testing.T, signer *ietf.BlsSigner, nbKeys int) (sks [][]byte, pks [][]byte) {
+	sks = make([][]byte, nbKeys)
+	pks = make([][]byte, nbKeys)
+	for i := 0; i < nbKeys; i++ {
+		ikm := make([]byte, ietf.MinIKMLen)
+		_, err := rand.Read(ikm)
+		require.Nil(t, err)
+
+		sks[i], err = ietf.KeyGen(ikm, nil)
+		require.Nil(t, err)
+		pks[i], err = signer.SkToPk(sks[i])
+		require.Nil(t, err)
+	}
+
+	return sks, pks
+}
+
+func createSigner(t *testing.T, variant ietf.Variant, scheme ietf.Scheme) *ietf.BlsSigner {
+	signer, err := ietf.NewBlsSigner(ietf.Ciphersuite{Variant: variant, Scheme: scheme})
+	require.Nil(t, err)
+
+	return signer
+}
+
+func TestNewBlsSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid variant should err", func(t *testing.T) {
+		signer, err := ietf.NewBlsSigner(ietf.Ciphersuite{Variant: 2})
+		require.Nil(t, signer)
+		require.Equal(t, crypto.ErrInvalidCiphersuite, err)
+	})
+	t.Run("invalid scheme should err", func(t *testing.T) {
+		signer, err := ietf.NewBlsSigner(ietf.Ciphersuite{Scheme: 3})
+		require.Nil(t, signer)
+		require.Equal(t, crypto.ErrInvalidCiphersuite, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		signer, err := ietf.NewBlsSigner(ietf.EthereumConsensus)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(signer))
+		require.Equal(t, ietf.EthereumConsensus.ID(), signer.CiphersuiteID())
+	})
+}
+
+// readTestVectors returns the space separated hex fields of every line of the test vectors file
+func readTestVectors(t *testing.T, fileName string, numFields int) [][][]byte {
+	file, err := os.Open(filepath.Join("testdata", fileName))
+	require.Nil(t, err)
+	defer func() {
+		require.Nil(t, file.Close())
+	}()
+
+	vectors := make([][][]byte, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		require.Equal(t, numFields, len(fields), fileName)
+
+		vector := make([][]byte, numFields)
+		for i, field := range fields {
+			vector[i], err = hex.DecodeString(field)
+			require.Nil(t, err, fileName)
+		}
+		vectors = append(vectors, vector)
+	}
+	require.Nil(t, scanner.Err())
+
+	return vectors
+}
+
+// referenceCoreSign computes the signature of the draft, sk * hash_to_point(msg), straight from the hash to curve
+// functions, to cross-check the fixtures generated with this package
+func referenceCoreSign(t *testing.T, variant ietf.Variant, sk []byte, msg []byte, dst string) []byte {
+	scalar := &bls.Fr{}
+	require.Nil(t, scalar.SetBigEndianMod(sk))
+
+	if variant == ietf.MinimalPublicKeySize {
+		hashPoint, err := mcl.HashToG2(msg, []byte(dst))
+		require.Nil(t, err)
+		sig := &bls.G2{}
+		bls.G2Mul(sig, hashPoint, scalar)
+
+		return mcl.G2ToZCashCompressed(sig)
+	}
+
+	hashPoint, err := mcl.HashToG1(msg, []byte(dst))
+	require.Nil(t, err)
+	sig := &bls.G1{}
+	bls.G1Mul(sig, hashPoint, scalar)
+
+	return mcl.G1ToZCashCompressed(sig)
+}
+
+// The test vectors of the reference implementation of the draft, https://github.com/kwantam/bls_sigs_ref, with the
+// basic scheme. Every line holds the message, the input key material of KeyGen and the expected signature
+func TestBlsSigner_DraftTestVectors(t *testing.T) {
+	t.Parallel()
+
+	vectorFiles := map[string]ietf.Variant{
+		"sig_g1_basic_P256.txt": ietf.MinimalSignatureSize,
+		"sig_g1_basic_P521.txt": ietf.MinimalSignatureSize,
+		"sig_g2_basic_P256.txt": ietf.MinimalPublicKeySize,
+		"sig_g2_basic_P521.txt": ietf.MinimalPublicKeySize,
+	}
+
+	for fileName, variant := range vectorFiles {
+		signer := createSigner(t, variant, ietf.Basic)
+
+		vectors := readTestVectors(t, fileName, 3)
+		require.Equal(t, 60, len(vectors))
+		for _, vector := range vectors {
+			msg, ikm, expectedSig := vector[0], vector[1], vector[2]
+
+			sk, err := ietf.KeyGen(ikm, nil)
+			require.Nil(t, err)
+			sig, err := signer.Sign(sk, msg)
+			require.Nil(t, err)
+			require.Equal(t, expectedSig, sig, fileName)
+
+			pk, _ := signer.SkToPk(sk)
+			require.Nil(t, signer.Verify(pk, msg, sig))
+		}
+	}
+}
+
+// The message augmentation and proof of possession test vectors. The reference implementation publishes its vectors
+// for these schemes in separate files which are not part of the testdata, so these are regression vectors generated
+// with this package from the messages and the input key material of the basic P256 vectors. Each one is cross-checked
+// against a signature computed straight from the hash to curve functions with the domain separation tag of the scheme
+func TestBlsSigner_AugmentationAndPopTestVectors(t *testing.T) {
+	t.Parallel()
+
+	vectorFiles := map[string]ietf.Ciphersuite{
+		"sig_g1_aug_P256.txt": {Variant: ietf.MinimalSignatureSize, Scheme: ietf.MessageAugmentation},
+		"sig_g1_pop_P256.txt": {Variant: ietf.MinimalSignatureSize, Scheme: ietf.ProofOfPossession},
+		"sig_g2_aug_P256.txt": {Variant: ietf.MinimalPublicKeySize, Scheme: ietf.MessageAugmentation},
+		"sig_g2_pop_P256.txt": {Variant: ietf.MinimalPublicKeySize, Scheme: ietf.ProofOfPossession},
+	}
+
+	for fileName, ciphersuite := range vectorFiles {
+		signer := createSigner(t, ciphersuite.Variant, ciphersuite.Scheme)
+
+		vectors := readTestVectors(t, fileName, 3)
+		require.Equal(t, 60, len(vectors))
+		for _, vector := range vectors {
+			msg, ikm, expectedSig := vector[0], vector[1], vector[2]
+
+			sk, err := ietf.KeyGen(ikm, nil)
+			require.Nil(t, err)
+			pk, _ := signer.SkToPk(sk)
+			sig, err := signer.Sign(sk, msg)
+			require.Nil(t, err)
+			require.Equal(t, expectedSig, sig, fileName)
+			require.Nil(t, signer.Verify(pk, msg, sig))
+
+			signedMsg := msg
+			if ciphersuite.Scheme == ietf.MessageAugmentation {
+				signedMsg = append(append([]byte{}, pk...), msg...)
+			}
+			require.Equal(t, expectedSig, referenceCoreSign(t, ciphersuite.Variant, sk, signedMsg, ciphersuite.ID()), fileName)
+		}
+	}
+}
+
+// The proof of possession test vectors, regression vectors generated as the ones of the other schemes. Every line holds
+// the input key material of KeyGen, the expected public key and the expected proof
+func TestBlsSigner_PopTestVectors(t *testing.T) {
+	t.Parallel()
+
+	vectorFiles := map[string]ietf.Variant{
+		"pop_g1_P256.txt": ietf.MinimalSignatureSize,
+		"pop_g2_P256.txt": ietf.MinimalPublicKeySize,
+	}
+
+	for fileName, variant := range vectorFiles {
+		signer := createSigner(t, variant, ietf.ProofOfPossession)
+		popTag := strings.Replace(signer.CiphersuiteID(), "BLS_SIG_", "BLS_POP_", 1)
+
+		vectors := readTestVectors(t, fileName, 3)
+		require.Equal(t, 60, len(vectors))
+		for _, vector := range vectors {
+			ikm, expectedPk, expectedProof := vector[0], vector[1], vector[2]
+
+			sk, err := ietf.KeyGen(ikm, nil)
+			require.Nil(t, err)
+			pk, err := signer.SkToPk(sk)
+			require.Nil(t, err)
+			require.Equal(t, expectedPk, pk, fileName)
+
+			proof, err := signer.PopProve(sk)
+			require.Nil(t, err)
+			require.Equal(t, expectedProof, proof, fileName)
+			require.Nil(t, signer.PopVerify(pk, proof))
+			require.Equal(t, expectedProof, referenceCoreSign(t, variant, sk, pk, popTag), fileName)
+		}
+	}
+}
+
+// The sign test vector of the Ethereum consensus specification, with the private key, the public key, the
+// message and the signature
+func TestBlsSigner_EthereumConsensusTestVector(t *testing.T) {
+	t.Parallel()
+
+	sk, _ := hex.DecodeString("263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3")
+	expectedPk := "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a"
+	msg := make([]byte, 32)
+	expectedSig := "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090" +
+		"352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"
+
+	signer, _ := ietf.NewBlsSigner(ietf.EthereumConsensus)
+
+	pk, err := signer.SkToPk(sk)
+	require.Nil(t, err)
+	require.Equal(t, expectedPk, hex.EncodeToString(pk))
+
+	sig, err := signer.Sign(sk, msg)
+	require.Nil(t, err)
+	require.Equal(t, expectedSig, hex.EncodeToString(sig))
+
+	require.Nil(t, signer.Verify(pk, msg, sig))
+}
+
+func TestBlsSigner_SkToPk(t *testing.T) {
+	t.Parallel()
+
+	signer := createSigner(t, ietf.MinimalSignatureSize, ietf.Basic)
+
+	t.Run("wrong length should err", func(t *testing.T) {
+		pk, err := signer.SkToPk(make([]byte, ietf.SecretKeyLen-1))
+		require.Nil(t, pk)
+		require.Equal(t, crypto.ErrInvalidPrivateKey, err)
+	})
+	t.Run("zero key should err", func(t *testing.T) {
+		pk, err := signer.SkToPk(make([]byte, ietf.SecretKeyLen))
+		require.Nil(t, pk)
+		require.Equal(t, crypto.ErrInvalidPrivateKey, err)
+	})
+	t.Run("key not lower than the group order should err", func(t *testing.T) {
+		order, _ := hex.DecodeString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001")
+		pk, err := signer.SkToPk(order)
+		require.Nil(t, pk)
+		require.Equal(t, crypto.ErrInvalidPrivateKey, err)
+	})
+	t.Run("public key sizes should follow the variant", func(t *testing.T) {
+		_, pks := createKeys(t, signer, 1)
+		require.Equal(t, 96, len(pks[0]))
+
+		_, pks = createKeys(t, createSigner(t, ietf.MinimalPublicKeySize, ietf.Basic), 1)
+		require.Equal(t, 48, len(pks[0]))
+	})
+}
+
+func TestBlsSigner_KeyValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, variant := range []ietf.Variant{ietf.MinimalSignatureSize, ietf.MinimalPublicKeySize} {
+		signer := createSigner(t, variant, ietf.Basic)
+		_, pks := createKeys(t, signer, 1)
+
+		require.Nil(t, signer.KeyValidate(pks[0]))
+
+		identity := make([]byte, len(pks[0]))
+		identity[0] = 0xc0
+		require.Equal(t, crypto.ErrInvalidPublicKey, signer.KeyValidate(identity))
+
+		invalid := append([]byte{}, pks[0]...)
+		invalid[len(invalid)-1] ^= 0x01
+		require.Equal(t, crypto.ErrInvalidPublicKey, signer.KeyValidate(invalid))
+	}
+}
+
+func TestBlsSigner_SignVerify(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	for _, ciphersuite := range allCiphersuites {
+		signer, _ := ietf.NewBlsSigner(ciphersuite)
+		sks, pks := createKeys(t, signer, 2)
+
+		sig, err := signer.Sign(sks[0], msg)
+		require.Nil(t, err, ciphersuite.ID())
+		require.Nil(t, signer.Verify(pks[0], msg, sig), ciphersuite.ID())
+
+		require.Equal(t, crypto.ErrSigNotValid, signer.Verify(pks[1], msg, sig), ciphersuite.ID())
+		require.Equal(t, crypto.ErrSigNotValid, signer.Verify(pks[0], []byte("other message"), sig), ciphersuite.ID())
+		require.Equal(t, crypto.ErrNilSignature, signer.Verify(pks[0], msg, nil), ciphersuite.ID())
+		require.Equal(t, crypto.ErrBLSInvalidSignature, signer.Verify(pks[0], msg, pks[0]), ciphersuite.ID())
+		require.Equal(t, crypto.ErrInvalidPublicKey, signer.Verify(sig, msg, sig), ciphersuite.ID())
+
+		emptyMsgSig, err := signer.Sign(sks[0], nil)
+		require.Nil(t, err, ciphersuite.ID())
+		require.Nil(t, signer.Verify(pks[0], nil, emptyMsgSig), ciphersuite.ID())
+	}
+}
+
+func TestBlsSigner_CiphersuitesShouldBeSeparated(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	for _, variant := range []ietf.Variant{ietf.MinimalSignatureSize, ietf.MinimalPublicKeySize} {
+		basic := createSigner(t, variant, ietf.Basic)
+		augmentation := createSigner(t, variant, ietf.MessageAugmentation)
+		pop := createSigner(t, variant, ietf.ProofOfPossession)
+		sks, pks := createKeys(t, basic, 1)
+
+		sig, _ := basic.Sign(sks[0], msg)
+		require.Equal(t, crypto.ErrSigNotValid, augmentation.Verify(pks[0], msg, sig))
+		require.Equal(t, crypto.ErrSigNotValid, pop.Verify(pks[0], msg, sig))
+
+		augmentedMsg := append(append([]byte{}, pks[0]...), msg...)
+		augmentedSig, _ := augmentation.Sign(sks[0], msg)
+		require.Equal(t, crypto.ErrSigNotValid, basic.Verify(pks[0], augmentedMsg, augmentedSig))
+	}
+}
+
+func TestBlsSigner_Aggregate(t *testing.T) {
+	t.Parallel()
+
+	signer := createSigner(t, ietf.MinimalPublicKeySize, ietf.Basic)
+
+	t.Run("no signatures should err", func(t *testing.T) {
+		aggSig, err := signer.Aggregate(nil)
+		require.Nil(t, aggSig)
+		require.Equal(t, crypto.ErrNilSignaturesList, err)
+	})
+	t.Run("invalid signature should err", func(t *testing.T) {
+		aggSig, err := signer.Aggregate([][]byte{[]byte("invalid")})
+		require.Nil(t, aggSig)
+		require.Equal(t, crypto.ErrBLSInvalidSignature, err)
+	})
+	t.Run("single signature should be kept", func(t *testing.T) {
+		sks, _ := createKeys(t, signer, 1)
+		sig, _ := signer.Sign(sks[0], []byte(testMessage))
+
+		aggSig, err := signer.Aggregate([][]byte{sig})
+		require.Nil(t, err)
+		require.Equal(t, sig, aggSig)
+	})
+}
+
+func TestBlsSigner_AggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	msgs := [][]byte{[]byte("message 0"), []byte("message 1"), []byte("message 2")}
+	sameMsgs := [][]byte{[]byte(testMessage), []byte(testMessage), []byte(testMessage)}
+
+	for _, ciphersuite := range allCiphersuites {
+		signer, _ := ietf.NewBlsSigner(ciphersuite)
+		sks, pks := createKeys(t, signer, len(msgs))
+
+		sigs := make([][]byte, len(msgs))
+		sameMsgSigs := make([][]byte, len(msgs))
+		for i := range sks {
+			sigs[i], _ = signer.Sign(sks[i], msgs[i])
+			sameMsgSigs[i], _ = signer.Sign(sks[i], sameMsgs[i])
+		}
+		aggSig, err := signer.Aggregate(sigs)
+		require.Nil(t, err)
+		sameMsgAggSig, _ := signer.Aggregate(sameMsgSigs)
+
+		require.Nil(t, signer.AggregateVerify(pks, msgs, aggSig), ciphersuite.ID())
+		require.Equal(t, crypto.ErrAggSigNotValid, signer.AggregateVerify(pks[1:], msgs[1:], aggSig), ciphersuite.ID())
+		require.Equal(t, crypto.ErrInvalidParam, signer.AggregateVerify(pks, msgs[1:], aggSig), ciphersuite.ID())
+		require.Equal(t, crypto.ErrNilPublicKeys, signer.AggregateVerify(nil, nil, aggSig), ciphersuite.ID())
+
+		err = signer.AggregateVerify(pks, sameMsgs, sameMsgAggSig)
+		if ciphersuite.Scheme == ietf.Basic {
+			require.Equal(t, crypto.ErrDuplicatedMessage, err, ciphersuite.ID())
+		} else {
+			require.Nil(t, err, ciphersuite.ID())
+		}
+	}
+}
+
+func TestBlsSigner_FastAggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+
+	t.Run("schemes without proofs of possession should err", func(t *testing.T) {
+		for _, scheme := range []ietf.Scheme{ietf.Basic, ietf.MessageAugmentation} {
+			signer := createSigner(t, ietf.MinimalPublicKeySize, scheme)
+			sks, pks := createKeys(t, signer, 1)
+			sig, _ := signer.Sign(sks[0], msg)
+
+			err := signer.FastAggregateVerify(pks, msg, sig)
+			require.Equal(t, crypto.ErrNotSupportedByCiphersuite, err)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		for _, variant := range []ietf.Variant{ietf.MinimalSignatureSize, ietf.MinimalPublicKeySize} {
+			signer := createSigner(t, variant, ietf.ProofOfPossession)
+			sks, pks := createKeys(t, signer, 5)
+
+			sigs := make([][]byte, len(sks))
+			for i := range sks {
+				sigs[i], _ = signer.Sign(sks[i], msg)
+			}
+			aggSig, _ := signer.Aggregate(sigs)
+
+			require.Nil(t, signer.FastAggregateVerify(pks, msg, aggSig))
+			require.Equal(t, crypto.ErrAggSigNotValid, signer.FastAggregateVerify(pks[1:], msg, aggSig))
+			require.Equal(t, crypto.ErrAggSigNotValid, signer.FastAggregateVerify(pks, []byte("other message"), aggSig))
+			require.Equal(t, crypto.ErrNilPublicKeys, signer.FastAggregateVerify(nil, msg, aggSig))
+
+			identity := make([]byte, len(pks[0]))
+			identity[0] = 0xc0
+			err := signer.FastAggregateVerify(append([][]byte{identity}, pks...), msg, aggSig)
+			require.Equal(t, crypto.ErrInvalidPublicKey, err)
+		}
+	})
+}
+
+func TestBlsSigner_PopProveVerify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("schemes without proofs of possession should err", func(t *testing.T) {
+		signer := createSigner(t, ietf.MinimalSignatureSize, ietf.Basic)
+		sks, pks := createKeys(t, signer, 1)
+
+		proof, err := signer.PopProve(sks[0])
+		require.Nil(t, proof)
+		require.Equal(t, crypto.ErrNotSupportedByCiphersuite, err)
+
+		err = signer.PopVerify(pks[0], pks[0])
+		require.Equal(t, crypto.ErrNotSupportedByCiphersuite, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		for _, variant := range []ietf.Variant{ietf.MinimalSignatureSize, ietf.MinimalPublicKeySize} {
+			signer := createSigner(t, variant, ietf.ProofOfPossession)
+			sks, pks := createKeys(t, signer, 2)
+
+			proof, err := signer.PopProve(sks[0])
+			require.Nil(t, err)
+			require.Nil(t, signer.PopVerify(pks[0], proof))
+			require.Equal(t, crypto.ErrPoPNotValid, signer.PopVerify(pks[1], proof))
+
+			// the proof is not a signature of the public key bytes, the domain separation tags differ
+			require.Equal(t, crypto.ErrSigNotValid, signer.Verify(pks[0], pks[0], proof))
+		}
+	})
+}
+
+func TestBlsSigner_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var signer *ietf.BlsSigner
+	require.True(t, check.IfNil(signer))
+
+	signer, _ = ietf.NewBlsSigner(ietf.EthereumConsensus)
+	require.False(t, check.IfNil(signer))
+}
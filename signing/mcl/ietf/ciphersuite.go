@@ -0,0 +1,84 @@
+package ietf
+
+// Variant selects the groups of the public keys and of the signatures
+type Variant uint8
+
+const (
+	// MinimalSignatureSize has the signatures on G1 (48 bytes) and the public keys on G2 (96 bytes)
+	MinimalSignatureSize Variant = iota
+	// MinimalPublicKeySize has the public keys on G1 (48 bytes) and the signatures on G2 (96 bytes)
+	MinimalPublicKeySize
+)
+
+// Scheme selects how the rogue key attacks are prevented when the signatures are aggregated
+type Scheme uint8
+
+const (
+	// Basic requires the messages of an aggregated signature to be distinct
+	Basic Scheme = iota
+	// MessageAugmentation prefixes every signed message with the public key of the signer
+	MessageAugmentation
+	// ProofOfPossession requires a proof of possession of the secret key for every public key, and allows
+	// the fast verification of the signatures aggregated over the same message
+	ProofOfPossession
+)
+
+const (
+	sigTagPrefix         = "BLS_SIG_"
+	popTagPrefix         = "BLS_POP_"
+	hashToG1Suite        = "BLS12381G1_XMD:SHA-256_SSWU_RO_"
+	hashToG2Suite        = "BLS12381G2_XMD:SHA-256_SSWU_RO_"
+	basicTag             = "NUL_"
+	augmentationTag      = "AUG_"
+	proofOfPossessionTag = "POP_"
+)
+
+// Ciphersuite is one of the ciphersuites defined by the BLS signature draft
+type Ciphersuite struct {
+	Variant Variant
+	Scheme  Scheme
+}
+
+// EthereumConsensus is the ciphersuite used by the Ethereum consensus layer,
+// BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_
+var EthereumConsensus = Ciphersuite{
+	Variant: MinimalPublicKeySize,
+	Scheme:  ProofOfPossession,
+}
+
+// ID returns the ciphersuite identifier, which is also the domain separation tag used to hash the messages
+func (c Ciphersuite) ID() string {
+	return sigTagPrefix + c.hashToCurveSuite() + c.schemeTag()
+}
+
+// popID returns the domain separation tag used to hash the public keys for the proofs of possession
+func (c Ciphersuite) popID() string {
+	return popTagPrefix + c.hashToCurveSuite() + proofOfPossessionTag
+}
+
+func (c Ciphersuite) isValid() bool {
+	isVariantValid := c.Variant == MinimalSignatureSize || c.Variant == MinimalPublicKeySize
+	isSchemeValid := c.Scheme == Basic || c.Scheme == MessageAugmentation || c.Scheme == ProofOfPossession
+
+	return isVariantValid && isSchemeValid
+}
+
+// hashToCurveSuite returns the hash to curve suite of the group of the signatures
+func (c Ciphersuite) hashToCurveSuite() string {
+	if c.Variant == MinimalPublicKeySize {
+		return hashToG2Suite
+	}
+
+	return hashToG1Suite
+}
+
+func (c Ciphersuite) schemeTag() string {
+	switch c.Scheme {
+	case MessageAugmentation:
+		return augmentationTag
+	case ProofOfPossession:
+		return proofOfPossessionTag
+	default:
+		return basicTag
+	}
+}
@@ -0,0 +1,26 @@
+package ietf_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/ietf"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCiphersuite_ID(t *testing.T) {
+	t.Parallel()
+
+	expectedIDs := map[ietf.Ciphersuite]string{
+		{Variant: ietf.MinimalSignatureSize, Scheme: ietf.Basic}:               "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_",
+		{Variant: ietf.MinimalSignatureSize, Scheme: ietf.MessageAugmentation}: "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_AUG_",
+		{Variant: ietf.MinimalSignatureSize, Scheme: ietf.ProofOfPossession}:   "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_",
+		{Variant: ietf.MinimalPublicKeySize, Scheme: ietf.Basic}:               "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_",
+		{Variant: ietf.MinimalPublicKeySize, Scheme: ietf.MessageAugmentation}: "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_",
+		{Variant: ietf.MinimalPublicKeySize, Scheme: ietf.ProofOfPossession}:   "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_",
+	}
+
+	for ciphersuite, expectedID := range expectedIDs {
+		require.Equal(t, expectedID, ciphersuite.ID())
+	}
+	require.Equal(t, "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_", ietf.EthereumConsensus.ID())
+}
@@ -0,0 +1,89 @@
+package ietf
+
+import (
+	"crypto/sha256"
+	"io"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// MinIKMLen is the minimum length of the input key material accepted by KeyGen
+	MinIKMLen = 32
+	// SecretKeyLen is the length of the serialized secret keys, I2OSP(SK, 32)
+	SecretKeyLen = 32
+	keyGenSalt   = "BLS-SIG-KEYGEN-SALT-"
+	keyGenOKMLen = 48
+)
+
+// KeyGen derives a secret key from the input key material and the optional key information, as the KeyGen procedure
+// of the BLS signature draft. The input key material must be at least 32 bytes of secret, uniformly random data.
+// The secret key is returned serialized as a 32 bytes big endian integer and it is valid for all the ciphersuites.
+// The salt is hashed only after an iteration that gives a zero key, as in the latest revisions of the draft. EIP-2333
+// follows an earlier revision that hashes the salt before the first iteration, so it derives other keys from a seed
+func KeyGen(ikm []byte, keyInfo []byte) ([]byte, error) {
+	if len(ikm) < MinIKMLen {
+		return nil, crypto.ErrInvalidKeyMaterial
+	}
+
+	ikmWithZero := make([]byte, len(ikm)+1)
+	copy(ikmWithZero, ikm)
+
+	infoWithLen := make([]byte, len(keyInfo)+2)
+	copy(infoWithLen, keyInfo)
+	infoWithLen[len(keyInfo)] = byte(keyGenOKMLen >> 8)
+	infoWithLen[len(keyInfo)+1] = byte(keyGenOKMLen)
+
+	salt := []byte(keyGenSalt)
+	okm := make([]byte, keyGenOKMLen)
+	sk := &bls.Fr{}
+	for {
+		reader := hkdf.New(sha256.New, ikmWithZero, salt, infoWithLen)
+		_, err := io.ReadFull(reader, okm)
+		if err != nil {
+			return nil, err
+		}
+
+		err = sk.SetBigEndianMod(okm)
+		if err != nil {
+			return nil, err
+		}
+		if !sk.IsZero() {
+			return secretKeyToBytes(sk), nil
+		}
+
+		digest := sha256.Sum256(salt)
+		salt = digest[:]
+	}
+}
+
+// secretKeyToBytes returns I2OSP(SK, 32), the herumi serialization being little endian
+func secretKeyToBytes(sk *bls.Fr) []byte {
+	return reverseBytes(sk.Serialize())
+}
+
+// secretKeyFromBytes parses a serialized secret key, which has to be in the range [1, r-1]
+func secretKeyFromBytes(data []byte) (*bls.Fr, error) {
+	if len(data) != SecretKeyLen {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	sk := &bls.Fr{}
+	err := sk.Deserialize(reverseBytes(data))
+	if err != nil || sk.IsZero() {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	return sk, nil
+}
+
+func reverseBytes(data []byte) []byte {
+	result := make([]byte, len(data))
+	for i := range data {
+		result[len(data)-1-i] = data[i]
+	}
+
+	return result
+}
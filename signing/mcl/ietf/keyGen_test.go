@@ -0,0 +1,91 @@
+package ietf_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/ietf"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyGen(t *testing.T) {
+	t.Parallel()
+
+	ikm := bytes.Repeat([]byte{0x42}, ietf.MinIKMLen)
+
+	t.Run("short input key material should err", func(t *testing.T) {
+		sk, err := ietf.KeyGen(ikm[1:], nil)
+		require.Nil(t, sk)
+		require.Equal(t, crypto.ErrInvalidKeyMaterial, err)
+	})
+	t.Run("same input should give the same key", func(t *testing.T) {
+		sk1, err := ietf.KeyGen(ikm, nil)
+		require.Nil(t, err)
+		require.Equal(t, ietf.SecretKeyLen, len(sk1))
+
+		sk2, _ := ietf.KeyGen(ikm, nil)
+		require.Equal(t, sk1, sk2)
+	})
+	t.Run("key info should change the key", func(t *testing.T) {
+		sk1, _ := ietf.KeyGen(ikm, nil)
+		sk2, err := ietf.KeyGen(ikm, []byte("key info"))
+		require.Nil(t, err)
+		require.NotEqual(t, sk1, sk2)
+	})
+}
+
+// referenceKeyGen derives the secret key with HKDF written out from RFC 5869, to cross-check the fixtures generated
+// with KeyGen. It covers the first iteration of the draft, the only one for all the practical inputs
+func referenceKeyGen(ikm []byte, keyInfo []byte) []byte {
+	order, _ := new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+	extract := hmac.New(sha256.New, []byte("BLS-SIG-KEYGEN-SALT-"))
+	extract.Write(ikm)
+	extract.Write([]byte{0})
+	prk := extract.Sum(nil)
+
+	info := append(append([]byte{}, keyInfo...), 0, 48)
+	okm := make([]byte, 0, 64)
+	block := make([]byte, 0)
+	for counter := byte(1); len(okm) < 48; counter++ {
+		expand := hmac.New(sha256.New, prk)
+		expand.Write(block)
+		expand.Write(info)
+		expand.Write([]byte{counter})
+		block = expand.Sum(nil)
+		okm = append(okm, block...)
+	}
+
+	sk := new(big.Int).SetBytes(okm[:48])
+	sk.Mod(sk, order)
+
+	return sk.FillBytes(make([]byte, ietf.SecretKeyLen))
+}
+
+// The KeyGen test vectors, regression vectors generated from the input key material of the basic P256 vectors of the
+// reference implementation, whose signatures already depend on these keys. Every line holds the input key material and
+// the expected secret key, derived without key information
+func TestKeyGen_TestVectors(t *testing.T) {
+	t.Parallel()
+
+	vectors := readTestVectors(t, "keygen.txt", 2)
+	require.Equal(t, 60, len(vectors))
+	for _, vector := range vectors {
+		ikm, expectedSk := vector[0], vector[1]
+
+		sk, err := ietf.KeyGen(ikm, nil)
+		require.Nil(t, err)
+		require.Equal(t, expectedSk, sk, hex.EncodeToString(ikm))
+		require.Equal(t, expectedSk, referenceKeyGen(ikm, nil))
+
+		keyInfo := []byte("key info")
+		sk, err = ietf.KeyGen(ikm, keyInfo)
+		require.Nil(t, err)
+		require.Equal(t, referenceKeyGen(ikm, keyInfo), sk)
+	}
+}
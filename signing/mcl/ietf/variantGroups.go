@@ -0,0 +1,275 @@
+package ietf
+
+import (
+	"runtime"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+)
+
+// variantGroups holds the operations that depend on the groups of the public keys and of the signatures. The points
+// are serialized in the ZCash compressed format and the generators are the ones of the BLS12-381 specification
+type variantGroups interface {
+	skToPk(sk *bls.Fr) []byte
+	coreSign(sk *bls.Fr, msg []byte, dst []byte) ([]byte, error)
+	keyValidate(pk []byte) error
+	aggregatePublicKeys(pks [][]byte) ([]byte, error)
+	aggregateSignatures(sigs [][]byte) ([]byte, error)
+	coreAggregateVerify(pks [][]byte, msgs [][]byte, sig []byte, dst []byte) (bool, error)
+}
+
+// minSignatureGroups has the public keys on G2 and the signatures on G1
+type minSignatureGroups struct {
+	generator    *bls.G2
+	negGenerator *bls.G2
+}
+
+func newMinSignatureGroups() *minSignatureGroups {
+	generator := mcl.NewStandardGeneratorG2()
+	negGenerator := &bls.G2{}
+	bls.G2Neg(negGenerator, generator)
+
+	return &minSignatureGroups{
+		generator:    generator,
+		negGenerator: negGenerator,
+	}
+}
+
+func (groups *minSignatureGroups) skToPk(sk *bls.Fr) []byte {
+	pk := &bls.G2{}
+	mcl.G2MulCT(pk, groups.generator, sk)
+	runtime.KeepAlive(sk)
+
+	return mcl.G2ToZCashCompressed(pk)
+}
+
+func (groups *minSignatureGroups) coreSign(sk *bls.Fr, msg []byte, dst []byte) ([]byte, error) {
+	hashPoint, err := mcl.HashToG1(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := &bls.G1{}
+	bls.G1MulCT(sig, hashPoint, sk)
+	runtime.KeepAlive(sk)
+
+	return mcl.G1ToZCashCompressed(sig), nil
+}
+
+func (groups *minSignatureGroups) keyValidate(pk []byte) error {
+	_, err := groups.publicKeyToPoint(pk)
+
+	return err
+}
+
+func (groups *minSignatureGroups) aggregatePublicKeys(pks [][]byte) ([]byte, error) {
+	aggregated := &bls.G2{}
+	aggregated.Clear()
+	for _, pk := range pks {
+		point, err := groups.publicKeyToPoint(pk)
+		if err != nil {
+			return nil, err
+		}
+
+		bls.G2Add(aggregated, aggregated, point)
+	}
+
+	return mcl.G2ToZCashCompressed(aggregated), nil
+}
+
+func (groups *minSignatureGroups) aggregateSignatures(sigs [][]byte) ([]byte, error) {
+	aggregated := &bls.G1{}
+	aggregated.Clear()
+	for _, sig := range sigs {
+		point, err := signatureToG1(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		bls.G1Add(aggregated, aggregated, point)
+	}
+
+	return mcl.G1ToZCashCompressed(aggregated), nil
+}
+
+// coreAggregateVerify checks e(sig, -g2) * prod(e(H(m_i), pk_i)) == 1
+func (groups *minSignatureGroups) coreAggregateVerify(pks [][]byte, msgs [][]byte, sig []byte, dst []byte) (bool, error) {
+	sigPoint, err := signatureToG1(sig)
+	if err != nil {
+		return false, err
+	}
+
+	pointsG1 := make([]bls.G1, 0, len(pks)+1)
+	pointsG2 := make([]bls.G2, 0, len(pks)+1)
+	pointsG1 = append(pointsG1, *sigPoint)
+	pointsG2 = append(pointsG2, *groups.negGenerator)
+	for i := range pks {
+		pkPoint, errPk := groups.publicKeyToPoint(pks[i])
+		if errPk != nil {
+			return false, errPk
+		}
+
+		hashPoint, errHash := mcl.HashToG1(msgs[i], dst)
+		if errHash != nil {
+			return false, errHash
+		}
+
+		pointsG1 = append(pointsG1, *hashPoint)
+		pointsG2 = append(pointsG2, *pkPoint)
+	}
+
+	return mcl.IsPairingProductOne(pointsG1, pointsG2), nil
+}
+
+// publicKeyToPoint deserializes the public key and applies KeyValidate: the point has to be in G2 and not the identity
+func (groups *minSignatureGroups) publicKeyToPoint(pk []byte) (*bls.G2, error) {
+	point, err := mcl.G2FromZCashCompressed(pk)
+	if err != nil || point.IsZero() {
+		return nil, crypto.ErrInvalidPublicKey
+	}
+
+	return point, nil
+}
+
+// minPublicKeyGroups has the public keys on G1 and the signatures on G2
+type minPublicKeyGroups struct {
+	generator    *bls.G1
+	negGenerator *bls.G1
+}
+
+func newMinPublicKeyGroups() *minPublicKeyGroups {
+	generator := mcl.NewPointG1().G1
+	negGenerator := &bls.G1{}
+	bls.G1Neg(negGenerator, generator)
+
+	return &minPublicKeyGroups{
+		generator:    generator,
+		negGenerator: negGenerator,
+	}
+}
+
+func (groups *minPublicKeyGroups) skToPk(sk *bls.Fr) []byte {
+	pk := &bls.G1{}
+	bls.G1MulCT(pk, groups.generator, sk)
+	runtime.KeepAlive(sk)
+
+	return mcl.G1ToZCashCompressed(pk)
+}
+
+func (groups *minPublicKeyGroups) coreSign(sk *bls.Fr, msg []byte, dst []byte) ([]byte, error) {
+	hashPoint, err := mcl.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := &bls.G2{}
+	mcl.G2MulCT(sig, hashPoint, sk)
+	runtime.KeepAlive(sk)
+
+	return mcl.G2ToZCashCompressed(sig), nil
+}
+
+func (groups *minPublicKeyGroups) keyValidate(pk []byte) error {
+	_, err := groups.publicKeyToPoint(pk)
+
+	return err
+}
+
+func (groups *minPublicKeyGroups) aggregatePublicKeys(pks [][]byte) ([]byte, error) {
+	aggregated := &bls.G1{}
+	aggregated.Clear()
+	for _, pk := range pks {
+		point, err := groups.publicKeyToPoint(pk)
+		if err != nil {
+			return nil, err
+		}
+
+		bls.G1Add(aggregated, aggregated, point)
+	}
+
+	return mcl.G1ToZCashCompressed(aggregated), nil
+}
+
+func (groups *minPublicKeyGroups) aggregateSignatures(sigs [][]byte) ([]byte, error) {
+	aggregated := &bls.G2{}
+	aggregated.Clear()
+	for _, sig := range sigs {
+		point, err := signatureToG2(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		bls.G2Add(aggregated, aggregated, point)
+	}
+
+	return mcl.G2ToZCashCompressed(aggregated), nil
+}
+
+// coreAggregateVerify checks e(-g1, sig) * prod(e(pk_i, H(m_i))) == 1
+func (groups *minPublicKeyGroups) coreAggregateVerify(pks [][]byte, msgs [][]byte, sig []byte, dst []byte) (bool, error) {
+	sigPoint, err := signatureToG2(sig)
+	if err != nil {
+		return false, err
+	}
+
+	pointsG1 := make([]bls.G1, 0, len(pks)+1)
+	pointsG2 := make([]bls.G2, 0, len(pks)+1)
+	pointsG1 = append(pointsG1, *groups.negGenerator)
+	pointsG2 = append(pointsG2, *sigPoint)
+	for i := range pks {
+		pkPoint, errPk := groups.publicKeyToPoint(pks[i])
+		if errPk != nil {
+			return false, errPk
+		}
+
+		hashPoint, errHash := mcl.HashToG2(msgs[i], dst)
+		if errHash != nil {
+			return false, errHash
+		}
+
+		pointsG1 = append(pointsG1, *pkPoint)
+		pointsG2 = append(pointsG2, *hashPoint)
+	}
+
+	return mcl.IsPairingProductOne(pointsG1, pointsG2), nil
+}
+
+// publicKeyToPoint deserializes the public key and applies KeyValidate: the point has to be in G1 and not the identity
+func (groups *minPublicKeyGroups) publicKeyToPoint(pk []byte) (*bls.G1, error) {
+	point, err := mcl.G1FromZCashCompressed(pk)
+	if err != nil || point.IsZero() {
+		return nil, crypto.ErrInvalidPublicKey
+	}
+
+	return point, nil
+}
+
+// signatureToG1 deserializes the signature and checks it is in G1. The identity is a valid signature point,
+// it is rejected by the pairing check unless it is the aggregation of signatures that cancel each other
+func signatureToG1(sig []byte) (*bls.G1, error) {
+	if len(sig) == 0 {
+		return nil, crypto.ErrNilSignature
+	}
+
+	point, err := mcl.G1FromZCashCompressed(sig)
+	if err != nil {
+		return nil, crypto.ErrBLSInvalidSignature
+	}
+
+	return point, nil
+}
+
+// signatureToG2 deserializes the signature and checks it is in G2
+func signatureToG2(sig []byte) (*bls.G2, error) {
+	if len(sig) == 0 {
+		return nil, crypto.ErrNilSignature
+	}
+
+	point, err := mcl.G2FromZCashCompressed(sig)
+	if err != nil {
+		return nil, crypto.ErrBLSInvalidSignature
+	}
+
+	return point, nil
+}
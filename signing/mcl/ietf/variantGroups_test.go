@@ -0,0 +1,58 @@
+package ietf
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSecretKeys(t *testing.T) []*bls.Fr {
+	secretKeys := make([]*bls.Fr, 0)
+	for _, value := range []string{
+		"1",
+		"2",
+		"73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000000",
+	} {
+		sk := &bls.Fr{}
+		require.Nil(t, sk.SetString(value, 16))
+		secretKeys = append(secretKeys, sk)
+	}
+
+	sk := &bls.Fr{}
+	sk.SetByCSPRNG()
+
+	return append(secretKeys, sk)
+}
+
+func TestMinSignatureGroups_SkToPk(t *testing.T) {
+	t.Parallel()
+
+	groups := newMinSignatureGroups()
+	for _, sk := range createTestSecretKeys(t) {
+		expected := &bls.G2{}
+		bls.G2Mul(expected, groups.generator, sk)
+
+		require.Equal(t, mcl.G2ToZCashCompressed(expected), groups.skToPk(sk), sk.GetString(10))
+	}
+}
+
+func TestMinPublicKeyGroups_CoreSign(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message to be signed")
+	dst := []byte(EthereumConsensus.ID())
+	groups := newMinPublicKeyGroups()
+	hashPoint, err := mcl.HashToG2(msg, dst)
+	require.Nil(t, err)
+
+	for _, sk := range createTestSecretKeys(t) {
+		expected := &bls.G2{}
+		bls.G2Mul(expected, hashPoint, sk)
+
+		sig, errSign := groups.coreSign(sk, msg, dst)
+		require.Nil(t, errSign)
+		require.Equal(t, mcl.G2ToZCashCompressed(expected), sig, sk.GetString(10))
+	}
+}
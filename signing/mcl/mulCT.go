@@ -0,0 +1,33 @@
+package mcl
+
+/*
+// mclBnG2_mulCT is part of the mcl library linked by the bls package, which binds only its G1 counterpart.
+// mclBnG2_serialize is bound by the bls package only through G2.Serialize, which allocates a new buffer on each call.
+// The parameters are declared as untyped pointers, as the mcl headers are not on the include path of this package
+#include <stddef.h>
+void mclBnG2_mulCT(void *z, const void *x, const void *y);
+size_t mclBnG2_serialize(void *buf, size_t maxBufSize, const void *x);
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/herumi/bls-go-binary/bls"
+)
+
+// G2MulCT sets out = x*y, in constant time with respect to y. It is the G2 counterpart of bls.G1MulCT, and must
+// be used instead of bls.G2Mul when y is secret
+func G2MulCT(out *bls.G2, x *bls.G2, y *bls.Fr) {
+	C.mclBnG2_mulCT(unsafe.Pointer(out), unsafe.Pointer(x), unsafe.Pointer(y))
+}
+
+// G2SerializeInto writes the serialization of x, the same as the one of x.Serialize, in buf without allocating. It
+// returns the number of bytes written, or 0 if buf is too small
+func G2SerializeInto(buf []byte, x *bls.G2) int {
+	if len(buf) == 0 {
+		return 0
+	}
+
+	return int(C.mclBnG2_serialize(unsafe.Pointer(&buf[0]), C.size_t(len(buf)), unsafe.Pointer(x)))
+}
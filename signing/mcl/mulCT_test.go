@@ -0,0 +1,48 @@
+package mcl
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestG2MulCT(t *testing.T) {
+	t.Parallel()
+
+	point, _ := NewPointG2().Pick()
+	for _, scalar := range createEdgeScalars() {
+		expected := &bls.G2{}
+		bls.G2Mul(expected, point.(*PointG2).G2, scalar.Scalar)
+
+		result := &bls.G2{}
+		G2MulCT(result, point.(*PointG2).G2, scalar.Scalar)
+		require.True(t, result.IsEqual(expected), scalar.Scalar.GetString(10))
+	}
+}
+
+func TestG2SerializeInto(t *testing.T) {
+	t.Run("too small buffer should not write", func(t *testing.T) {
+		point, _ := NewPointG2().Pick()
+
+		require.Equal(t, 0, G2SerializeInto(nil, point.(*PointG2).G2))
+		require.Equal(t, 0, G2SerializeInto(make([]byte, bls.GetG2ByteSize()-1), point.(*PointG2).G2))
+	})
+	t.Run("should give the same serialization", func(t *testing.T) {
+		point, _ := NewPointG2().Pick()
+		for _, g2 := range []*bls.G2{point.(*PointG2).G2, NewPointG2().G2, {}} {
+			buf := make([]byte, bls.GetG2ByteSize()+10)
+			n := G2SerializeInto(buf, g2)
+			require.Equal(t, g2.Serialize(), buf[:n])
+		}
+	})
+	t.Run("should not allocate", func(t *testing.T) {
+		point, _ := NewPointG2().Pick()
+		buf := make([]byte, bls.GetG2ByteSize())
+
+		allocs := testing.AllocsPerRun(10, func() {
+			_ = G2SerializeInto(buf, point.(*PointG2).G2)
+		})
+		require.Equal(t, float64(0), allocs)
+	})
+}
@@ -0,0 +1,80 @@
+package mcl
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+/*
+Multi-scalar multiplication computes sum(s_i*P_i) for n points at once. Instead of n full scalar multiplications
+followed by n-1 additions, the scalars are split in windows of c bits and, for each window, every point is added to the
+bucket given by its c bits of scalar (Pippenger's bucket method). The buckets are then combined with about 2^c
+additions per window, so the cost is roughly (255/c)*(n + 2^c) additions, instead of n*255 doublings and additions.
+
+The computation is done natively by the mcl library (mulVec). The points are copied in a contiguous array before the
+call, as mcl may normalize the input points in place.
+
+Multi-scalar multiplication is not constant time and must only be used with public scalars, such as the rogue key
+coefficients or the weights of the signers, not with secret keys.
+*/
+
+// MultiScalarMulG1 computes sum(scalars[i]*points[i]) over G1
+func MultiScalarMulG1(points []*PointG1, scalars []*Scalar) (*PointG1, error) {
+	scalarsFr, err := scalarsToFr(scalars, len(points))
+	if err != nil {
+		return nil, err
+	}
+
+	pointsG1 := make([]bls.G1, len(points))
+	for i, point := range points {
+		if point == nil || point.G1 == nil {
+			return nil, crypto.ErrNilParam
+		}
+
+		pointsG1[i] = *point.G1
+	}
+
+	result := &PointG1{G1: &bls.G1{}}
+	bls.G1MulVec(result.G1, pointsG1, scalarsFr)
+
+	return result, nil
+}
+
+// MultiScalarMulG2 computes sum(scalars[i]*points[i]) over G2
+func MultiScalarMulG2(points []*PointG2, scalars []*Scalar) (*PointG2, error) {
+	scalarsFr, err := scalarsToFr(scalars, len(points))
+	if err != nil {
+		return nil, err
+	}
+
+	pointsG2 := make([]bls.G2, len(points))
+	for i, point := range points {
+		if point == nil || point.G2 == nil {
+			return nil, crypto.ErrNilParam
+		}
+
+		pointsG2[i] = *point.G2
+	}
+
+	result := &PointG2{G2: &bls.G2{}}
+	bls.G2MulVec(result.G2, pointsG2, scalarsFr)
+
+	return result, nil
+}
+
+func scalarsToFr(scalars []*Scalar, numPoints int) ([]bls.Fr, error) {
+	if numPoints == 0 || len(scalars) != numPoints {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	scalarsFr := make([]bls.Fr, len(scalars))
+	for i, scalar := range scalars {
+		if scalar == nil || scalar.Scalar == nil {
+			return nil, crypto.ErrNilParam
+		}
+
+		scalarsFr[i] = *scalar.Scalar
+	}
+
+	return scalarsFr, nil
+}
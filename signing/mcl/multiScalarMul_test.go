@@ -0,0 +1,165 @@
+package mcl
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPointsG1(n int) ([]*PointG1, []*Scalar) {
+	points := make([]*PointG1, n)
+	scalars := make([]*Scalar, n)
+	for i := 0; i < n; i++ {
+		point, _ := NewPointG1().Pick()
+		points[i] = point.(*PointG1)
+		scalars[i] = NewScalar()
+	}
+
+	return points, scalars
+}
+
+func createRandomPointsG2(n int) ([]*PointG2, []*Scalar) {
+	points := make([]*PointG2, n)
+	scalars := make([]*Scalar, n)
+	for i := 0; i < n; i++ {
+		point, _ := NewPointG2().Pick()
+		points[i] = point.(*PointG2)
+		scalars[i] = NewScalar()
+	}
+
+	return points, scalars
+}
+
+func TestMultiScalarMulG1(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid lists should err", func(t *testing.T) {
+		points, scalars := createRandomPointsG1(3)
+
+		result, err := MultiScalarMulG1(nil, nil)
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+
+		result, err = MultiScalarMulG1(points, scalars[:2])
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+
+		result, err = MultiScalarMulG1([]*PointG1{points[0], nil}, scalars[:2])
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		result, err = MultiScalarMulG1(points[:2], []*Scalar{scalars[0], {}})
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("should equal the sum of the products", func(t *testing.T) {
+		points, scalars := createRandomPointsG1(100)
+		pointsCopy := make([]bls.G1, len(points))
+		expected := &bls.G1{}
+		for i := range points {
+			pointsCopy[i] = *points[i].G1
+
+			product := &bls.G1{}
+			bls.G1Mul(product, points[i].G1, scalars[i].Scalar)
+			bls.G1Add(expected, expected, product)
+		}
+
+		result, err := MultiScalarMulG1(points, scalars)
+		require.Nil(t, err)
+		assert.True(t, expected.IsEqual(result.G1))
+
+		for i := range points {
+			assert.True(t, pointsCopy[i].IsEqual(points[i].G1))
+		}
+	})
+}
+
+func TestMultiScalarMulG2(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid lists should err", func(t *testing.T) {
+		points, scalars := createRandomPointsG2(3)
+
+		result, err := MultiScalarMulG2(nil, nil)
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+
+		result, err = MultiScalarMulG2(points[:1], scalars)
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+
+		result, err = MultiScalarMulG2([]*PointG2{points[0], {}}, scalars[:2])
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("should equal the sum of the products", func(t *testing.T) {
+		points, scalars := createRandomPointsG2(50)
+		expected := &bls.G2{}
+		for i := range points {
+			product := &bls.G2{}
+			bls.G2Mul(product, points[i].G2, scalars[i].Scalar)
+			bls.G2Add(expected, expected, product)
+		}
+
+		result, err := MultiScalarMulG2(points, scalars)
+		require.Nil(t, err)
+		assert.True(t, expected.IsEqual(result.G2))
+	})
+	t.Run("single point should equal the product", func(t *testing.T) {
+		points, scalars := createRandomPointsG2(1)
+		expected, _ := points[0].Mul(scalars[0])
+
+		result, err := MultiScalarMulG2(points, scalars)
+		require.Nil(t, err)
+
+		eq, _ := expected.Equal(result)
+		assert.True(t, eq)
+	})
+}
+
+func BenchmarkMultiScalarMulG1_400(b *testing.B) {
+	points, scalars := createRandomPointsG1(400)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = MultiScalarMulG1(points, scalars)
+	}
+}
+
+func BenchmarkMulAndAddG1_400(b *testing.B) {
+	points, scalars := createRandomPointsG1(400)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum crypto.Point = NewPointG1().Null()
+		for j := range points {
+			product, _ := points[j].Mul(scalars[j])
+			sum, _ = sum.Add(product)
+		}
+	}
+}
+
+func BenchmarkMultiScalarMulG2_400(b *testing.B) {
+	points, scalars := createRandomPointsG2(400)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = MultiScalarMulG2(points, scalars)
+	}
+}
+
+func BenchmarkMulAndAddG2_400(b *testing.B) {
+	points, scalars := createRandomPointsG2(400)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum crypto.Point = NewPointG2().Null()
+		for j := range points {
+			product, _ := points[j].Mul(scalars[j])
+			sum, _ = sum.Add(product)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package multisig
 
 import (
+	"strings"
+
 	"github.com/herumi/bls-go-binary/bls"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
@@ -25,6 +27,7 @@ This is where the modified BLS scheme comes into play and prevents this attacks
 */
 
 var _ crypto.LowLevelSignerBLS = (*BlsMultiSigner)(nil)
+var _ crypto.LowLevelPubKeysAggregatorBLS = (*BlsMultiSigner)(nil)
 
 // HasherOutputSize - configured hasher needs to generate hashes on 16 bytes
 const HasherOutputSize = 16
@@ -33,6 +36,12 @@ const HasherOutputSize = 16
 type BlsMultiSigner struct {
 	singlesig.BlsSingleSigner
 	Hasher hashing.Hasher
+	// Cache is optional, if set the rogue key coefficients and the aggregated public key are computed only once per
+	// validator set and then reused for aggregation and verification
+	Cache *CoefficientsCache
+	// NumWorkers is optional, if greater than 1 the shares and the public keys are decoded, checked, hashed and scaled
+	// by this number of workers in parallel. The results are the same as the ones of the sequential processing
+	NumWorkers int
 }
 
 // SignShare produces a BLS signature share (single BLS signature) over a given message
@@ -77,15 +86,13 @@ func (bms *BlsMultiSigner) AggregateSignatures(
 		return nil, crypto.ErrInvalidSuite
 	}
 
-	sigsBLS, err := bms.prepareSignatures(suite, signatures, pubKeysSigners)
+	aggSig := &bls.G1{}
+	err := bms.prepareSignatures(aggSig, suite, signatures, pubKeysSigners)
 	if err != nil {
 		return nil, err
 	}
 
-	aggSigBLS := &bls.Sign{}
-	aggSigBLS.Aggregate(sigsBLS)
-
-	return aggSigBLS.Serialize(), nil
+	return aggSig.Serialize(), nil
 }
 
 // VerifyAggregatedSig verifies if a BLS aggregated signature is valid over a given message
@@ -113,7 +120,7 @@ func (bms *BlsMultiSigner) VerifyAggregatedSig(
 		return crypto.ErrInvalidSuite
 	}
 
-	preparedPubKeys, err := preparePublicKeys(pubKeys, bms.Hasher, suite)
+	aggPubKey, err := bms.aggregatedPublicKey(suite, pubKeys)
 	if err != nil {
 		return err
 	}
@@ -124,114 +131,309 @@ func (bms *BlsMultiSigner) VerifyAggregatedSig(
 		return err
 	}
 
-	res := aggSig.FastAggregateVerify(preparedPubKeys, msg)
-	if !res {
-		return crypto.ErrAggSigNotValid
+	return fastAggregateVerify(&bms.BlsSingleSigner, aggSig, []bls.PublicKey{*aggPubKey}, msg)
+}
+
+// AggregatePublicKeys aggregates the public keys into one public key, as the sum of the public keys weighted with
+// their rogue key coefficients: sum(H1(pk_i, {pk_1, ..., pk_n})*pk_i)
+func (bms *BlsMultiSigner) AggregatePublicKeys(suite crypto.Suite, pubKeys []crypto.PublicKey) (crypto.PublicKey, error) {
+	if check.IfNil(suite) {
+		return nil, crypto.ErrNilSuite
+	}
+	if len(pubKeys) == 0 {
+		return nil, crypto.ErrNilPublicKeys
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return nil, crypto.ErrInvalidSuite
+	}
+
+	err := checkPublicKeys(pubKeys, bms.NumWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	aggPubKey, err := bms.aggregatedPublicKey(suite, pubKeys)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return aggregatedPubKeyFromPoints(suite, []bls.PublicKey{*aggPubKey})
 }
 
-func preparePublicKeys(
-	pubKeys []crypto.PublicKey,
-	hasher hashing.Hasher,
+// VerifyAggregatedSigWithAggregatedPubKey verifies a BLS aggregated signature over a given message
+// against a public key previously aggregated with AggregatePublicKeys
+func (bms *BlsMultiSigner) VerifyAggregatedSigWithAggregatedPubKey(
 	suite crypto.Suite,
-) ([]bls.PublicKey, error) {
-	var hPk []byte
-	var prepPublicKeyPoint crypto.Point
-	var pubKeyPoint crypto.Point
-	prepPubKeysPoints := make([]bls.PublicKey, len(pubKeys))
+	aggPubKey crypto.PublicKey,
+	aggSigBytes []byte,
+	msg []byte,
+) error {
+	return verifyWithAggregatedPubKey(&bms.BlsSingleSigner, suite, aggPubKey, aggSigBytes, msg)
+}
 
-	concatPKs, err := concatPubKeys(pubKeys)
+// RegisterAggregatedPubKey registers the aggregated public key of the given signers in the pairing lines cache, so
+// that the verifications of the signatures aggregated by exactly this set of signers use its precomputed lines. The
+// aggregated public key changes with every subset of signers, so it is only worth registering for the sets that sign
+// often, such as a whole consensus group
+func (bms *BlsMultiSigner) RegisterAggregatedPubKey(suite crypto.Suite, pubKeys []crypto.PublicKey) error {
+	if check.IfNil(bms.PairingLines) {
+		return crypto.ErrNilPairingLinesCache
+	}
+
+	aggPubKey, err := bms.AggregatePublicKeys(suite, pubKeys)
+	if err != nil {
+		return err
+	}
+
+	return registerPubKey(bms.PairingLines, aggPubKey)
+}
+
+// aggregatedPublicKey returns sum(t_i*pk_i), from the cache if set
+func (bms *BlsMultiSigner) aggregatedPublicKey(suite crypto.Suite, pubKeys []crypto.PublicKey) (*bls.PublicKey, error) {
+	if check.IfNil(bms.Cache) {
+		return preparePublicKeys(pubKeys, bms.Hasher, suite, bms.NumWorkers)
+	}
+
+	setCoefficients, err := bms.getValidatorSetCoefficients(suite, pubKeys)
 	if err != nil {
 		return nil, err
 	}
 
-	for i, pubKey := range pubKeys {
-		if check.IfNil(pubKey) {
-			return nil, crypto.ErrNilPublicKey
-		}
+	aggPubKey := setCoefficients.aggregatedPubKey
 
-		pubKeyPoint = pubKey.Point()
+	return &aggPubKey, nil
+}
 
-		// t_i = H(pk_i, {pk_1, ..., pk_n})
-		hPk, err = hashPublicKeyPoints(hasher, pubKeyPoint, concatPKs)
+// rogueKeyCoefficients returns the rogue key coefficients t_i of the public keys, from the cache if set
+func (bms *BlsMultiSigner) rogueKeyCoefficients(suite crypto.Suite, pubKeys []crypto.PublicKey) ([]bls.Fr, error) {
+	if check.IfNil(bms.Cache) {
+		concatPKs, err := concatPubKeys(pubKeys)
 		if err != nil {
 			return nil, err
 		}
 
-		// t_i*pubKey_i
-		prepPublicKeyPoint, err = scalarMulPk(suite, hPk, pubKeyPoint)
+		_, coefficients, err := publicKeysCoefficients(pubKeys, concatPKs, bms.Hasher, suite, bms.NumWorkers)
+		return coefficients, err
+	}
+
+	setCoefficients, err := bms.getValidatorSetCoefficients(suite, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return setCoefficients.coefficients, nil
+}
+
+// setCoefficients returns the rogue key coefficients and the aggregated public key of the checked public keys, from
+// the cache if set
+func (bms *BlsMultiSigner) setCoefficients(suite crypto.Suite, pubKeys []crypto.PublicKey) (*validatorSetCoefficients, error) {
+	if check.IfNil(bms.Cache) {
+		concatPKs, err := concatPubKeys(pubKeys)
 		if err != nil {
 			return nil, err
 		}
 
-		prepPubKeyG2, ok := prepPublicKeyPoint.GetUnderlyingObj().(*bls.G2)
-		if !ok {
-			return nil, crypto.ErrInvalidPoint
+		return computeValidatorSetCoefficients(bms.Hasher, suite, pubKeys, concatPKs, bms.NumWorkers)
+	}
+
+	return bms.getValidatorSetCoefficients(suite, pubKeys)
+}
+
+// preparePublicKeys returns the aggregated public key sum(t_i*pk_i), computed with one multi-scalar multiplication
+func preparePublicKeys(
+	pubKeys []crypto.PublicKey,
+	hasher hashing.Hasher,
+	suite crypto.Suite,
+	numWorkers int,
+) (*bls.PublicKey, error) {
+	concatPKs, err := concatPubKeys(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeysPoints, coefficients, err := publicKeysCoefficients(pubKeys, concatPKs, hasher, suite, numWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	// sum(t_i*pubKey_i)
+	aggPubKey := &bls.G2{}
+	err = multiScalarMulG2(aggPubKey, pubKeysPoints, coefficients, numWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.CastToPublicKey(aggPubKey), nil
+}
+
+// publicKeysCoefficients returns the points of the public keys and their rogue key coefficients
+// t_i = H1(pk_i, {pk_1, ..., pk_n}), in contiguous buffers
+func publicKeysCoefficients(
+	pubKeys []crypto.PublicKey,
+	concatPKs []byte,
+	hasher hashing.Hasher,
+	suite crypto.Suite,
+	numWorkers int,
+) ([]bls.G2, []bls.Fr, error) {
+	if check.IfNil(suite) {
+		return nil, nil, crypto.ErrNilSuite
+	}
+
+	pubKeysPoints := make([]bls.G2, len(pubKeys))
+	coefficients := make([]bls.Fr, len(pubKeys))
+	err := processInParallel(len(pubKeys), numWorkers, func(_ int, start int, end int) error {
+		for i := start; i < end; i++ {
+			pubKeyPoint := pubKeys[i].Point()
+			mclPointG2, isPoint := pubKeyPoint.(*mcl.PointG2)
+			if !isPoint || mclPointG2.G2 == nil {
+				return crypto.ErrInvalidPublicKey
+			}
+
+			// t_i = H(pk_i, {pk_1, ..., pk_n})
+			hPk, err := hashPublicKeyPoints(hasher, pubKeyPoint, concatPKs)
+			if err != nil {
+				return err
+			}
+
+			// the hash is read as a big endian number, the same as its hex encoding read by createScalar
+			err = coefficients[i].SetBigEndianMod(hPk)
+			if err != nil {
+				return err
+			}
+
+			pubKeysPoints[i] = *mclPointG2.G2
 		}
-		prepPubKeysPoints[i] = *bls.CastToPublicKey(prepPubKeyG2)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return prepPubKeysPoints, nil
+	return pubKeysPoints, coefficients, nil
 }
 
+// prepareSignatures sets aggSig to the aggregated signature sum(t_i*sig_i), computed with one multi-scalar
+// multiplication
 func (bms *BlsMultiSigner) prepareSignatures(
+	aggSig *bls.G1,
 	suite crypto.Suite,
 	signatures [][]byte,
 	pubKeysSigners []crypto.PublicKey,
-) ([]bls.Sign, error) {
+) error {
 	if len(signatures) == 0 {
-		return nil, crypto.ErrNilSignaturesList
+		return crypto.ErrNilSignaturesList
 	}
-	concatPKs, err := concatPubKeys(pubKeysSigners)
+
+	sigsPoints, coefficients, err := bms.signaturesCoefficients(suite, signatures, pubKeysSigners)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var hPk []byte
-	var sPointG1 *mcl.PointG1
-	prepSigs := make([]bls.Sign, 0)
-
-	for i, sig := range signatures {
-		sigBLS := &bls.Sign{}
-		if len(sig) == 0 {
-			return nil, crypto.ErrNilSignature
-		}
+	// sum(H1(pubKey_i)*sig_i)
+	return multiScalarMulG1(aggSig, sigsPoints, coefficients, bms.NumWorkers)
+}
 
-		err = sigBLS.Deserialize(sig)
+// signaturesCoefficients returns the points of the signatures, decoded in one contiguous buffer, and the rogue key
+// coefficients of their signers
+func (bms *BlsMultiSigner) signaturesCoefficients(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+) ([]bls.G1, []bls.Fr, error) {
+	if check.IfNil(bms.Cache) {
+		// the cached validator sets are validated once, when computing their coefficients
+		err := checkPublicKeys(pubKeysSigners, bms.NumWorkers)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+	}
 
-		if !singlesig.IsSigValidPoint(sigBLS) {
-			return nil, crypto.ErrBLSInvalidSignature
+	sigsPoints := make([]bls.G1, len(signatures))
+	err := processInParallel(len(signatures), bms.NumWorkers, func(_ int, start int, end int) error {
+		for i := start; i < end; i++ {
+			errDecode := sigBytesIntoPoint(signatures[i], &sigsPoints[i])
+			if errDecode != nil {
+				return errDecode
+			}
 		}
 
-		pubKeyPoint := pubKeysSigners[i].Point()
-		mclPointG2, isPoint := pubKeyPoint.(*mcl.PointG2)
-		if !isPoint || !singlesig.IsPubKeyPointValid(mclPointG2) {
-			return nil, crypto.ErrInvalidPublicKey
-		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-		sigPoint := mcl.NewPointG1()
-		sigPoint.G1 = bls.CastFromSign(sigBLS)
+	coefficients, err := bms.rogueKeyCoefficients(suite, pubKeysSigners)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(signatures) != len(coefficients) {
+		return nil, nil, crypto.ErrInvalidParam
+	}
 
-		hPk, err = hashPublicKeyPoints(bms.Hasher, pubKeyPoint, concatPKs)
-		if err != nil {
-			return nil, err
-		}
-		// H1(pubKey_i)*sig_i
-		sPointG1, err = scalarMulSig(suite, hPk, sigPoint)
-		if err != nil {
-			return nil, err
-		}
+	return sigsPoints, coefficients, nil
+}
 
-		sigBLS = bls.CastToSign(sPointG1.G1)
-		prepSigs = append(prepSigs, *sigBLS)
+// getValidatorSetCoefficients returns the rogue key coefficients and the aggregated public key for the given
+// validator set, from the cache if available, otherwise computes and caches them
+func (bms *BlsMultiSigner) getValidatorSetCoefficients(
+	suite crypto.Suite,
+	pubKeys []crypto.PublicKey,
+) (*validatorSetCoefficients, error) {
+	if check.IfNil(bms.Hasher) {
+		return nil, crypto.ErrNilHasher
 	}
 
-	return prepSigs, nil
+	concatPKs, err := concatPubKeys(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	setCoefficients, found := bms.Cache.get(bms.Hasher, concatPKs)
+	if found {
+		return setCoefficients, nil
+	}
+
+	setCoefficients, err = computeValidatorSetCoefficients(bms.Hasher, suite, pubKeys, concatPKs, bms.NumWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	bms.Cache.put(bms.Hasher, concatPKs, setCoefficients)
+
+	return setCoefficients, nil
+}
+
+func computeValidatorSetCoefficients(
+	hasher hashing.Hasher,
+	suite crypto.Suite,
+	pubKeys []crypto.PublicKey,
+	concatPKs []byte,
+	numWorkers int,
+) (*validatorSetCoefficients, error) {
+	err := checkPublicKeys(pubKeys, numWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeysPoints, coefficients, err := publicKeysCoefficients(pubKeys, concatPKs, hasher, suite, numWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	// sum(t_i*pubKey_i)
+	aggPubKey := &bls.G2{}
+	err = multiScalarMulG2(aggPubKey, pubKeysPoints, coefficients, numWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &validatorSetCoefficients{
+		coefficients:     coefficients,
+		aggregatedPubKey: *bls.CastToPublicKey(aggPubKey),
+	}, nil
 }
 
 // concatenatePubKeys concatenates the public keys
@@ -241,7 +443,6 @@ func concatPubKeys(pubKeys []crypto.PublicKey) ([]byte, error) {
 	}
 
 	var point crypto.Point
-	var pointBytes []byte
 	var err error
 	sizeBytesPubKey := pubKeys[0].Suite().PointLen()
 	result := make([]byte, 0, len(pubKeys)*sizeBytesPubKey)
@@ -256,17 +457,34 @@ func concatPubKeys(pubKeys []crypto.PublicKey) ([]byte, error) {
 			return nil, crypto.ErrNilPublicKeyPoint
 		}
 
-		pointBytes, err = point.MarshalBinary()
+		result, err = appendPointBytes(result, point)
 		if err != nil {
 			return nil, err
 		}
-
-		result = append(result, pointBytes...)
 	}
 
 	return result, nil
 }
 
+// appendPointBytes appends the serialization of the point to buff. The G2 points are serialized directly in the free
+// capacity of buff, so concatenating the public keys in a buffer sized for all of them does not allocate for each key
+func appendPointBytes(buff []byte, point crypto.Point) ([]byte, error) {
+	mclPointG2, isPointG2 := point.(*mcl.PointG2)
+	if isPointG2 && mclPointG2.G2 != nil {
+		n := mcl.G2SerializeInto(buff[len(buff):cap(buff)], mclPointG2.G2)
+		if n > 0 {
+			return buff[:len(buff)+n], nil
+		}
+	}
+
+	pointBytes, err := point.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buff, pointBytes...), nil
+}
+
 // hashPublicKeyPoints hashes the concatenation of public keys with the given public key poiint
 func hashPublicKeyPoints(hasher hashing.Hasher, pubKeyPoint crypto.Point, concatPubKeys []byte) ([]byte, error) {
 	if check.IfNil(hasher) {
@@ -282,15 +500,24 @@ func hashPublicKeyPoints(hasher hashing.Hasher, pubKeyPoint crypto.Point, concat
 		return nil, crypto.ErrNilPublicKeyPoint
 	}
 
-	blsPoint, ok := pubKeyPoint.GetUnderlyingObj().(*bls.G2)
-	if !ok {
+	var blsPointString string
+	switch blsPoint := pubKeyPoint.GetUnderlyingObj().(type) {
+	case *bls.G2:
+		blsPointString = blsPoint.GetString(16)
+	case *bls.G1:
+		// public keys of the "minimal public key" variant
+		blsPointString = blsPoint.GetString(16)
+	default:
 		return nil, crypto.ErrInvalidPoint
 	}
-	blsPointString := blsPoint.GetString(16)
-	concatPkWithPKs := append([]byte(blsPointString), concatPubKeys...)
+	// the hashed input is built directly as a string, as the hasher takes a string
+	concatPkWithPKs := strings.Builder{}
+	concatPkWithPKs.Grow(len(blsPointString) + len(concatPubKeys))
+	concatPkWithPKs.WriteString(blsPointString)
+	concatPkWithPKs.Write(concatPubKeys)
 
 	// H1(pk_i, {pk_1, ..., pk_n})
-	h := hasher.Compute(string(concatPkWithPKs))
+	h := hasher.Compute(concatPkWithPKs.String())
 	// accepted length 32, copy the hasherOutputSize bytes and have rest 0
 	h32 := make([]byte, 32)
 	copy(h32[HasherOutputSize:], h)
@@ -0,0 +1,216 @@
+package multisig
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+/*
+This implementation aggregates BLS single signatures over distinct messages and verifies them against the
+(public key, message) pairs of the signers, as the AggregateVerify procedure from the BLS signature draft:
+https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature
+
+The aggregated signature is the plain sum of the signatures, and it is checked with one multi-pairing:
+e(aggSig, g2) == prod(e(H(m_i), pk_i))
+
+Plain aggregation is susceptible to rogue key attacks, which are prevented in one of the following ways:
+- distinct messages: the verification rejects the aggregated signature if two of the messages are the same
+- message augmentation: every signer signs the concatenation of its public key with the message, so the signed
+messages are distinct even if the signers attest the same message
+*/
+
+var _ crypto.LowLevelAggregateSignerBLS = (*BlsAggregateSigner)(nil)
+
+// BlsAggregateSigner provides an implementation of the crypto.LowLevelAggregateSignerBLS interface
+type BlsAggregateSigner struct {
+	singlesig.BlsSingleSigner
+	// MessageAugmentation, if set, prefixes each message with the signer's public key before signing and verifying,
+	// otherwise the messages of an aggregated signature are required to be distinct
+	MessageAugmentation bool
+}
+
+// SignShare produces a BLS signature share over a given message, augmented with the public key if configured
+func (bas *BlsAggregateSigner) SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error) {
+	if check.IfNil(privKey) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if !bas.MessageAugmentation {
+		return bas.Sign(privKey, message)
+	}
+	if len(message) == 0 {
+		return nil, crypto.ErrNilMessage
+	}
+
+	pubKey := privKey.GeneratePublic()
+	augmentedMsg, err := augmentMessage(pubKey, message)
+	if err != nil {
+		return nil, err
+	}
+
+	return bas.Sign(privKey, augmentedMsg)
+}
+
+// VerifySigShare verifies a BLS signature share over a given message, augmented with the public key if configured
+func (bas *BlsAggregateSigner) VerifySigShare(pubKey crypto.PublicKey, message []byte, sig []byte) error {
+	if !bas.MessageAugmentation {
+		return bas.Verify(pubKey, message, sig)
+	}
+	if len(message) == 0 {
+		return crypto.ErrNilMessage
+	}
+
+	augmentedMsg, err := augmentMessage(pubKey, message)
+	if err != nil {
+		return err
+	}
+
+	return bas.Verify(pubKey, augmentedMsg, sig)
+}
+
+// VerifySigBytes provides an "cheap" integrity check of a signature given as a byte array
+// It does not validate the signature over a message, only verifies that it is a signature
+func (bas *BlsAggregateSigner) VerifySigBytes(_ crypto.Suite, sig []byte) error {
+	if len(sig) == 0 {
+		return crypto.ErrNilSignature
+	}
+
+	_, err := sigBytesToPoint(sig)
+
+	return err
+}
+
+// AggregateSignatures produces the aggregation of BLS single signatures over possibly distinct messages
+func (bas *BlsAggregateSigner) AggregateSignatures(suite crypto.Suite, signatures [][]byte) ([]byte, error) {
+	if check.IfNil(suite) {
+		return nil, crypto.ErrNilSuite
+	}
+	if len(signatures) == 0 {
+		return nil, crypto.ErrNilSignaturesList
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return nil, crypto.ErrInvalidSuite
+	}
+
+	var err error
+	var sigBLS *bls.Sign
+	sigsBLS := make([]bls.Sign, 0, len(signatures))
+	for _, sig := range signatures {
+		sigBLS, err = sigBytesToSig(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		sigsBLS = append(sigsBLS, *sigBLS)
+	}
+
+	aggSigBLS := &bls.Sign{}
+	aggSigBLS.Aggregate(sigsBLS)
+
+	return aggSigBLS.Serialize(), nil
+}
+
+// AggregateVerify verifies a BLS aggregated signature, where messages[i] was signed with pubKeys[i]
+func (bas *BlsAggregateSigner) AggregateVerify(
+	suite crypto.Suite,
+	pubKeys []crypto.PublicKey,
+	messages [][]byte,
+	aggSigBytes []byte,
+) error {
+	if check.IfNil(suite) {
+		return crypto.ErrNilSuite
+	}
+	if len(pubKeys) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+	if len(pubKeys) != len(messages) {
+		return crypto.ErrInvalidParam
+	}
+	if len(aggSigBytes) == 0 {
+		return crypto.ErrNilSignature
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return crypto.ErrInvalidSuite
+	}
+
+	signedMessages, err := bas.prepareMessages(pubKeys, messages)
+	if err != nil {
+		return err
+	}
+
+	pubKeysG2, err := pubKeysCryptoToValidG2(pubKeys)
+	if err != nil {
+		return err
+	}
+
+	aggSig, err := sigBytesToSig(aggSigBytes)
+	if err != nil {
+		return err
+	}
+
+	res, err := verifyDistinctMessagesPairing(&bas.BlsSingleSigner, bls.CastFromSign(aggSig), pubKeysG2, signedMessages)
+	if err != nil {
+		return err
+	}
+	if !res {
+		return crypto.ErrAggSigNotValid
+	}
+
+	return nil
+}
+
+func (bas *BlsAggregateSigner) prepareMessages(pubKeys []crypto.PublicKey, messages [][]byte) ([][]byte, error) {
+	signedMessages := make([][]byte, 0, len(messages))
+	seenMessages := make(map[string]struct{}, len(messages))
+	for i, msg := range messages {
+		if len(msg) == 0 {
+			return nil, crypto.ErrNilMessage
+		}
+
+		if bas.MessageAugmentation {
+			augmentedMsg, err := augmentMessage(pubKeys[i], msg)
+			if err != nil {
+				return nil, err
+			}
+
+			signedMessages = append(signedMessages, augmentedMsg)
+			continue
+		}
+
+		_, found := seenMessages[string(msg)]
+		if found {
+			return nil, crypto.ErrDuplicatedMessage
+		}
+		seenMessages[string(msg)] = struct{}{}
+
+		signedMessages = append(signedMessages, msg)
+	}
+
+	return signedMessages, nil
+}
+
+// augmentMessage returns the concatenation of the public key bytes with the message
+func augmentMessage(pubKey crypto.PublicKey, message []byte) ([]byte, error) {
+	if check.IfNil(pubKey) {
+		return nil, crypto.ErrNilPublicKey
+	}
+
+	pubKeyBytes, err := pubKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	augmentedMsg := make([]byte, 0, len(pubKeyBytes)+len(message))
+	augmentedMsg = append(augmentedMsg, pubKeyBytes...)
+
+	return append(augmentedMsg, message...), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bas *BlsAggregateSigner) IsInterfaceNil() bool {
+	return bas == nil
+}
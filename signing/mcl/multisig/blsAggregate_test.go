@@ -0,0 +1,227 @@
+package multisig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/stretchr/testify/require"
+)
+
+func createDistinctMessagesSigShares(
+	nbSigs int,
+	llSigner crypto.LowLevelAggregateSignerBLS,
+) (pubKeys []crypto.PublicKey, messages [][]byte, sigShares [][]byte) {
+	suite := mcl.NewSuiteBLS12()
+	kg := signing.NewKeyGenerator(suite)
+
+	pubKeys = make([]crypto.PublicKey, nbSigs)
+	messages = make([][]byte, nbSigs)
+	sigShares = make([][]byte, nbSigs)
+
+	for i := 0; i < nbSigs; i++ {
+		sk, pk := kg.GeneratePair()
+		pubKeys[i] = pk
+		messages[i] = []byte(fmt.Sprintf("%s %d", testMessage, i))
+		sigShares[i], _ = llSigner.SignShare(sk, messages[i])
+	}
+
+	return pubKeys, messages, sigShares
+}
+
+func TestBlsAggregateSigner_SignShare(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk, pk := kg.GeneratePair()
+
+	t.Run("nil private key should err", func(t *testing.T) {
+		llSig := &multisig.BlsAggregateSigner{MessageAugmentation: true}
+		sig, err := llSig.SignShare(nil, msg)
+		require.Equal(t, crypto.ErrNilPrivateKey, err)
+		require.Nil(t, sig)
+	})
+	t.Run("nil msg should err", func(t *testing.T) {
+		llSig := &multisig.BlsAggregateSigner{MessageAugmentation: true}
+		sig, err := llSig.SignShare(sk, nil)
+		require.Equal(t, crypto.ErrNilMessage, err)
+		require.Nil(t, sig)
+	})
+	t.Run("without augmentation should sign the message", func(t *testing.T) {
+		llSig := &multisig.BlsAggregateSigner{}
+		sig, err := llSig.SignShare(sk, msg)
+		require.Nil(t, err)
+
+		err = llSig.Verify(pk, msg, sig)
+		require.Nil(t, err)
+		err = llSig.VerifySigShare(pk, msg, sig)
+		require.Nil(t, err)
+	})
+	t.Run("with augmentation should sign the public key and the message", func(t *testing.T) {
+		llSig := &multisig.BlsAggregateSigner{MessageAugmentation: true}
+		sig, err := llSig.SignShare(sk, msg)
+		require.Nil(t, err)
+
+		err = llSig.Verify(pk, msg, sig)
+		require.Equal(t, crypto.ErrSigNotValid, err)
+
+		pkBytes, _ := pk.ToByteArray()
+		err = llSig.Verify(pk, append(pkBytes, msg...), sig)
+		require.Nil(t, err)
+		err = llSig.VerifySigShare(pk, msg, sig)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsAggregateSigner_AggregateSignatures(t *testing.T) {
+	t.Parallel()
+
+	llSig := &multisig.BlsAggregateSigner{}
+	pubKeys, _, sigShares := createDistinctMessagesSigShares(5, llSig)
+	suite := pubKeys[0].Suite()
+
+	t.Run("nil suite should err", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(nil, sigShares)
+		require.Equal(t, crypto.ErrNilSuite, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("invalid suite should err", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(createMockSuite("invalid suite"), sigShares)
+		require.Equal(t, crypto.ErrInvalidSuite, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("empty signatures should err", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(suite, nil)
+		require.Equal(t, crypto.ErrNilSignaturesList, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("invalid signature should err", func(t *testing.T) {
+		sigs := [][]byte{sigShares[0], []byte("invalid")}
+		aggSig, err := llSig.AggregateSignatures(suite, sigs)
+		require.NotNil(t, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("aggregate OK", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(suite, sigShares)
+		require.Nil(t, err)
+		require.NotNil(t, aggSig)
+	})
+}
+
+func TestBlsAggregateSigner_AggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	llSig := &multisig.BlsAggregateSigner{}
+	pubKeys, messages, sigShares := createDistinctMessagesSigShares(10, llSig)
+	suite := pubKeys[0].Suite()
+	aggSig, err := llSig.AggregateSignatures(suite, sigShares)
+	require.Nil(t, err)
+
+	t.Run("nil suite should err", func(t *testing.T) {
+		err = llSig.AggregateVerify(nil, pubKeys, messages, aggSig)
+		require.Equal(t, crypto.ErrNilSuite, err)
+	})
+	t.Run("invalid suite should err", func(t *testing.T) {
+		err = llSig.AggregateVerify(createMockSuite("invalid suite"), pubKeys, messages, aggSig)
+		require.Equal(t, crypto.ErrInvalidSuite, err)
+	})
+	t.Run("empty pub keys should err", func(t *testing.T) {
+		err = llSig.AggregateVerify(suite, nil, messages, aggSig)
+		require.Equal(t, crypto.ErrNilPublicKeys, err)
+	})
+	t.Run("messages count mismatch should err", func(t *testing.T) {
+		err = llSig.AggregateVerify(suite, pubKeys, messages[1:], aggSig)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("empty aggregated sig should err", func(t *testing.T) {
+		err = llSig.AggregateVerify(suite, pubKeys, messages, nil)
+		require.Equal(t, crypto.ErrNilSignature, err)
+	})
+	t.Run("empty message should err", func(t *testing.T) {
+		msgs := make([][]byte, len(messages))
+		copy(msgs, messages)
+		msgs[3] = nil
+
+		err = llSig.AggregateVerify(suite, pubKeys, msgs, aggSig)
+		require.Equal(t, crypto.ErrNilMessage, err)
+	})
+	t.Run("nil public key should err", func(t *testing.T) {
+		pks := make([]crypto.PublicKey, len(pubKeys))
+		copy(pks, pubKeys)
+		pks[2] = nil
+
+		err = llSig.AggregateVerify(suite, pks, messages, aggSig)
+		require.Equal(t, crypto.ErrNilPublicKey, err)
+	})
+	t.Run("swapped messages should err", func(t *testing.T) {
+		msgs := make([][]byte, len(messages))
+		copy(msgs, messages)
+		msgs[0], msgs[1] = msgs[1], msgs[0]
+
+		err = llSig.AggregateVerify(suite, pubKeys, msgs, aggSig)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+	t.Run("verify OK", func(t *testing.T) {
+		err = llSig.AggregateVerify(suite, pubKeys, messages, aggSig)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsAggregateSigner_AggregateVerifyWithDST(t *testing.T) {
+	t.Parallel()
+
+	signer, _ := singlesig.NewBlsSignerWithDST([]byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_"))
+	llSig := &multisig.BlsAggregateSigner{BlsSingleSigner: *signer}
+	pubKeys, messages, sigShares := createDistinctMessagesSigShares(10, llSig)
+	suite := pubKeys[0].Suite()
+	aggSig, err := llSig.AggregateSignatures(suite, sigShares)
+	require.Nil(t, err)
+
+	err = llSig.AggregateVerify(suite, pubKeys, messages, aggSig)
+	require.Nil(t, err)
+
+	llSigWithoutDST := &multisig.BlsAggregateSigner{}
+	err = llSigWithoutDST.AggregateVerify(suite, pubKeys, messages, aggSig)
+	require.Equal(t, crypto.ErrAggSigNotValid, err)
+}
+
+func TestBlsAggregateSigner_AggregateVerifyDuplicatedMessages(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	sk1, pk1 := kg.GeneratePair()
+	sk2, pk2 := kg.GeneratePair()
+	pubKeys := []crypto.PublicKey{pk1, pk2}
+	messages := [][]byte{msg, msg}
+
+	t.Run("without augmentation should err", func(t *testing.T) {
+		llSig := &multisig.BlsAggregateSigner{}
+		sig1, _ := llSig.SignShare(sk1, msg)
+		sig2, _ := llSig.SignShare(sk2, msg)
+		aggSig, err := llSig.AggregateSignatures(kg.Suite(), [][]byte{sig1, sig2})
+		require.Nil(t, err)
+
+		err = llSig.AggregateVerify(kg.Suite(), pubKeys, messages, aggSig)
+		require.Equal(t, crypto.ErrDuplicatedMessage, err)
+	})
+	t.Run("with augmentation should work", func(t *testing.T) {
+		llSig := &multisig.BlsAggregateSigner{MessageAugmentation: true}
+		sig1, _ := llSig.SignShare(sk1, msg)
+		sig2, _ := llSig.SignShare(sk2, msg)
+		aggSig, err := llSig.AggregateSignatures(kg.Suite(), [][]byte{sig1, sig2})
+		require.Nil(t, err)
+
+		err = llSig.AggregateVerify(kg.Suite(), pubKeys, messages, aggSig)
+		require.Nil(t, err)
+
+		llSigNoAugmentation := &multisig.BlsAggregateSigner{}
+		err = llSigNoAugmentation.AggregateVerify(kg.Suite(), pubKeys, [][]byte{msg, []byte("other")}, aggSig)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+}
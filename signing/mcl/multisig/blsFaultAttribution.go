@@ -0,0 +1,331 @@
+package multisig
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+/*
+Fault attribution for aggregated signatures. The shares are aggregated and verified optimistically, without verifying
+them one by one, which costs a single pairing check when all the signers are honest. Only if the aggregated signature
+is not valid, the invalid shares are searched by divide and conquer:
+
+  - the shares that can not be decoded, or are not valid points, are invalid
+  - the remaining shares are split in halves, and every half is verified as a sub-aggregate against the sub-aggregate of
+    its public keys, with the same coefficients. A valid half holds only valid shares, an invalid half is split again,
+    until single invalid shares are reached. If the left half of an invalid range is valid, the right half is invalid,
+    so it is split without being verified
+
+With k invalid shares out of n, this takes O(k*log(n)) pairing checks instead of n. Each share is scaled by a random
+coefficient in the sub-aggregates, so that invalid shares crafted to cancel each other out in a sum are still found,
+and a share is reported as invalid only after it failed the verification by itself, so honest signers are never blamed.
+*/
+
+var _ crypto.LowLevelFaultAttributionSignerBLS = (*BlsMultiSigner)(nil)
+var _ crypto.LowLevelFaultAttributionSignerBLS = (*BlsMultiSignerKOSK)(nil)
+
+// AggregateAndVerifySignatures aggregates the signature shares and verifies the aggregated signature over the
+// message. If the aggregated signature is not valid, the invalid shares are identified and returned in an
+// *crypto.InvalidSharesError. The aggregated signature is the same as the one of AggregateSignatures
+func (bms *BlsMultiSigner) AggregateAndVerifySignatures(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+	msg []byte,
+) ([]byte, error) {
+	err := checkFaultAttributionArgs(suite, signatures, pubKeysSigners, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	setCoefficients, err := bms.setCoefficients(suite, pubKeysSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeysPoints, err := pubKeysToPointsG2(pubKeysSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	aggPubKey := bls.CastFromPublicKey(&setCoefficients.aggregatedPubKey)
+
+	return aggregateAndAttributeFaults(
+		&bms.BlsSingleSigner,
+		signatures,
+		pubKeysPoints,
+		setCoefficients.coefficients,
+		aggPubKey,
+		msg,
+		bms.NumWorkers,
+	)
+}
+
+// AggregateAndVerifySignatures aggregates the signature shares and verifies the aggregated signature over the
+// message. If the aggregated signature is not valid, the invalid shares are identified and returned in an
+// *crypto.InvalidSharesError. The aggregated signature is the same as the one of AggregateSignatures
+func (bms *BlsMultiSignerKOSK) AggregateAndVerifySignatures(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+	msg []byte,
+) ([]byte, error) {
+	err := checkFaultAttributionArgs(suite, signatures, pubKeysSigners, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeysG2, err := pubKeysCryptoToValidG2(pubKeysSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	aggPubKey := &bls.G2{}
+	for i := range pubKeysG2 {
+		bls.G2Add(aggPubKey, aggPubKey, &pubKeysG2[i])
+	}
+
+	return aggregateAndAttributeFaults(&bms.BlsSingleSigner, signatures, pubKeysG2, nil, aggPubKey, msg, 0)
+}
+
+func checkFaultAttributionArgs(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+	msg []byte,
+) error {
+	if check.IfNil(suite) {
+		return crypto.ErrNilSuite
+	}
+	if len(signatures) == 0 {
+		return crypto.ErrNilSignaturesList
+	}
+	if len(pubKeysSigners) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+	if len(pubKeysSigners) != len(signatures) {
+		return crypto.ErrInvalidParam
+	}
+	if len(msg) == 0 {
+		return crypto.ErrNilMessage
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return crypto.ErrInvalidSuite
+	}
+
+	return nil
+}
+
+// faultAttribution holds the decoded shares and the data needed to verify their sub-aggregates
+type faultAttribution struct {
+	indexes     []int
+	sigsPoints  []bls.G1
+	pubKeys     []bls.G2
+	scalars     []bls.Fr
+	hashPoint   bls.G1
+	numWorkers  int
+	invalidSigs []int
+}
+
+// aggregateAndAttributeFaults aggregates the shares, each one scaled by its coefficient, or by 1 if no coefficients
+// are given, and verifies the result against the aggregated public key. If it is not valid, it returns an
+// *crypto.InvalidSharesError with the indexes of the invalid shares
+func aggregateAndAttributeFaults(
+	signer *singlesig.BlsSingleSigner,
+	signatures [][]byte,
+	pubKeysPoints []bls.G2,
+	coefficients []bls.Fr,
+	aggPubKey *bls.G2,
+	msg []byte,
+	numWorkers int,
+) ([]byte, error) {
+	if len(signatures) != len(pubKeysPoints) || (coefficients != nil && len(coefficients) != len(signatures)) {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	fa, err := newFaultAttribution(signer, signatures, pubKeysPoints, coefficients, msg, numWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	allSharesDecoded := len(fa.invalidSigs) == 0
+	if allSharesDecoded {
+		aggSig, valid, errVerify := fa.verifyAggregatedSig(aggPubKey)
+		if errVerify != nil {
+			return nil, errVerify
+		}
+		if valid {
+			return aggSig, nil
+		}
+	}
+
+	err = fa.randomizeScalars()
+	if err != nil {
+		return nil, err
+	}
+
+	// if all the shares were aggregated, the aggregation was just found invalid, so it is not verified again
+	err = fa.searchInvalidShares(0, len(fa.indexes), allSharesDecoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fa.invalidSigs) == 0 {
+		return nil, crypto.ErrAggSigNotValid
+	}
+
+	return nil, &crypto.InvalidSharesError{
+		Indexes: fa.invalidSigs,
+	}
+}
+
+func newFaultAttribution(
+	signer *singlesig.BlsSingleSigner,
+	signatures [][]byte,
+	pubKeysPoints []bls.G2,
+	coefficients []bls.Fr,
+	msg []byte,
+	numWorkers int,
+) (*faultAttribution, error) {
+	fa := &faultAttribution{
+		indexes:     make([]int, 0, len(signatures)),
+		sigsPoints:  make([]bls.G1, len(signatures)),
+		pubKeys:     make([]bls.G2, 0, len(signatures)),
+		scalars:     make([]bls.Fr, 0, len(signatures)),
+		numWorkers:  numWorkers,
+		invalidSigs: make([]int, 0),
+	}
+
+	hashPoint, err := signer.HashToG1(msg)
+	if err != nil {
+		return nil, err
+	}
+	fa.hashPoint = *hashPoint
+
+	numDecoded := 0
+	for i, sig := range signatures {
+		// the shares are decoded in place, the slot of a malformed share is reused by the next share
+		errDecode := sigBytesIntoPoint(sig, &fa.sigsPoints[numDecoded])
+		if errDecode != nil {
+			// the malformed shares are invalid without any verification
+			fa.invalidSigs = append(fa.invalidSigs, i)
+			continue
+		}
+
+		scalar := bls.Fr{}
+		scalar.SetInt64(1)
+		if coefficients != nil {
+			scalar = coefficients[i]
+		}
+
+		numDecoded++
+		fa.indexes = append(fa.indexes, i)
+		fa.pubKeys = append(fa.pubKeys, pubKeysPoints[i])
+		fa.scalars = append(fa.scalars, scalar)
+	}
+	fa.sigsPoints = fa.sigsPoints[:numDecoded]
+
+	return fa, nil
+}
+
+// verifyAggregatedSig aggregates all the decoded shares, scaled by their coefficients, and verifies the result
+// against the aggregated public key
+func (fa *faultAttribution) verifyAggregatedSig(aggPubKey *bls.G2) ([]byte, bool, error) {
+	aggSig := &bls.G1{}
+	err := multiScalarMulG1(aggSig, fa.sigsPoints, fa.scalars, fa.numWorkers)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return aggSig.Serialize(), fa.isPairingValid(aggSig, aggPubKey), nil
+}
+
+// randomizeScalars multiplies the coefficients by random non-zero scalars r_i, so that the sub-aggregates of invalid
+// shares can not cancel each other out
+func (fa *faultAttribution) randomizeScalars() error {
+	r := &bls.Fr{}
+	for i := range fa.scalars {
+		err := mcl.SetRandomNonZeroShortScalar(r)
+		if err != nil {
+			return err
+		}
+
+		bls.FrMul(&fa.scalars[i], &fa.scalars[i], r)
+	}
+
+	return nil
+}
+
+// searchInvalidShares finds the invalid shares in the decoded shares [start, end), by divide and conquer. If
+// knownInvalid is set, the sub-aggregate of the range is known to be invalid and it is not verified again, unless it
+// holds a single share
+func (fa *faultAttribution) searchInvalidShares(start int, end int, knownInvalid bool) error {
+	if start >= end {
+		return nil
+	}
+
+	if !knownInvalid || end-start == 1 {
+		valid, err := fa.isSubAggregateValid(start, end)
+		if err != nil {
+			return err
+		}
+		if valid {
+			return nil
+		}
+	}
+
+	if end-start == 1 {
+		fa.addInvalidShare(fa.indexes[start])
+		return nil
+	}
+
+	mid := start + (end-start)/2
+	numInvalidBefore := len(fa.invalidSigs)
+	err := fa.searchInvalidShares(start, mid, false)
+	if err != nil {
+		return err
+	}
+
+	// the range is invalid, so if its left half is valid, its right half is invalid
+	leftHalfValid := len(fa.invalidSigs) == numInvalidBefore
+
+	return fa.searchInvalidShares(mid, end, leftHalfValid)
+}
+
+// isSubAggregateValid verifies e(sum(s_i*sig_i), g2) == e(H(m), sum(s_i*pk_i)) for the decoded shares [start, end)
+func (fa *faultAttribution) isSubAggregateValid(start int, end int) (bool, error) {
+	aggSig := &bls.G1{}
+	err := multiScalarMulG1(aggSig, fa.sigsPoints[start:end], fa.scalars[start:end], fa.numWorkers)
+	if err != nil {
+		return false, err
+	}
+
+	aggPubKey := &bls.G2{}
+	err = multiScalarMulG2(aggPubKey, fa.pubKeys[start:end], fa.scalars[start:end], fa.numWorkers)
+	if err != nil {
+		return false, err
+	}
+
+	return fa.isPairingValid(aggSig, aggPubKey), nil
+}
+
+// isPairingValid checks e(sig, g2) == e(H(m), pubKey)
+func (fa *faultAttribution) isPairingValid(sig *bls.G1, pubKey *bls.G2) bool {
+	return singlesig.IsPairingValid(sig, &fa.hashPoint, pubKey)
+}
+
+// addInvalidShare inserts the index of an invalid share, keeping the indexes sorted
+func (fa *faultAttribution) addInvalidShare(index int) {
+	pos := len(fa.invalidSigs)
+	for pos > 0 && fa.invalidSigs[pos-1] > index {
+		pos--
+	}
+
+	fa.invalidSigs = append(fa.invalidSigs, 0)
+	copy(fa.invalidSigs[pos+1:], fa.invalidSigs[pos:])
+	fa.invalidSigs[pos] = index
+}
@@ -0,0 +1,152 @@
+package multisig_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/stretchr/testify/require"
+)
+
+func createFaultAttributionLowLevelSigners(t *testing.T) map[string]crypto.LowLevelSignerBLS {
+	hasher, err := blake2b.NewBlake2bWithSize(blsHashSize)
+	require.Nil(t, err)
+	cache, _ := multisig.NewCoefficientsCache(10)
+
+	return map[string]crypto.LowLevelSignerBLS{
+		"with rogue key prevention":             &multisig.BlsMultiSigner{Hasher: hasher},
+		"with rogue key prevention and cache":   &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache},
+		"with rogue key prevention in parallel": &multisig.BlsMultiSigner{Hasher: hasher, NumWorkers: 4},
+		"with KOSK":                             &multisig.BlsMultiSignerKOSK{},
+	}
+}
+
+func requireInvalidShares(t *testing.T, expectedIndexes []int, aggSig []byte, err error) {
+	require.Nil(t, aggSig)
+	require.True(t, errors.Is(err, crypto.ErrAggSigNotValid))
+
+	invalidSharesErr, ok := err.(*crypto.InvalidSharesError)
+	require.True(t, ok)
+	require.Equal(t, expectedIndexes, invalidSharesErr.Indexes)
+}
+
+func TestBlsMultiSigner_AggregateAndVerifySignaturesInvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	for name, llSig := range createFaultAttributionLowLevelSigners(t) {
+		faultAttributionSigner := llSig.(crypto.LowLevelFaultAttributionSignerBLS)
+		pubKeys, sigShares := createSigSharesBLS(4, msg, llSig)
+		suite := pubKeys[0].Suite()
+
+		t.Run(name, func(t *testing.T) {
+			aggSig, err := faultAttributionSigner.AggregateAndVerifySignatures(nil, sigShares, pubKeys, msg)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrNilSuite, err)
+
+			aggSig, err = faultAttributionSigner.AggregateAndVerifySignatures(createMockSuite("invalid suite"), sigShares, pubKeys, msg)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrInvalidSuite, err)
+
+			aggSig, err = faultAttributionSigner.AggregateAndVerifySignatures(suite, nil, pubKeys, msg)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrNilSignaturesList, err)
+
+			aggSig, err = faultAttributionSigner.AggregateAndVerifySignatures(suite, sigShares, nil, msg)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrNilPublicKeys, err)
+
+			aggSig, err = faultAttributionSigner.AggregateAndVerifySignatures(suite, sigShares[:3], pubKeys, msg)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrInvalidParam, err)
+
+			aggSig, err = faultAttributionSigner.AggregateAndVerifySignatures(suite, sigShares, pubKeys, nil)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrNilMessage, err)
+
+			invalidPubKeys := append([]crypto.PublicKey{}, pubKeys...)
+			invalidPubKeys[1] = nil
+			aggSig, err = faultAttributionSigner.AggregateAndVerifySignatures(suite, sigShares, invalidPubKeys, msg)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrNilPublicKey, err)
+		})
+	}
+}
+
+func TestBlsMultiSigner_AggregateAndVerifySignatures(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	for name, llSig := range createFaultAttributionLowLevelSigners(t) {
+		faultAttributionSigner := llSig.(crypto.LowLevelFaultAttributionSignerBLS)
+		pubKeys, sigShares := createSigSharesBLS(64, msg, llSig)
+		suite := pubKeys[0].Suite()
+		_, otherSigShares := createSigSharesBLS(4, msg, llSig)
+
+		t.Run(name+": valid shares should aggregate", func(t *testing.T) {
+			aggSig, err := faultAttributionSigner.AggregateAndVerifySignatures(suite, sigShares, pubKeys, msg)
+			require.Nil(t, err)
+
+			expectedAggSig, _ := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+			require.Equal(t, expectedAggSig, aggSig)
+		})
+		t.Run(name+": one invalid share should be found", func(t *testing.T) {
+			for _, idx := range []int{0, 17, 63} {
+				invalidSigShares := append([][]byte{}, sigShares...)
+				invalidSigShares[idx] = otherSigShares[0]
+
+				aggSig, err := faultAttributionSigner.AggregateAndVerifySignatures(suite, invalidSigShares, pubKeys, msg)
+				requireInvalidShares(t, []int{idx}, aggSig, err)
+			}
+		})
+		t.Run(name+": several invalid shares should be found", func(t *testing.T) {
+			invalidSigShares := append([][]byte{}, sigShares...)
+			invalidSigShares[3] = otherSigShares[0]
+			invalidSigShares[4] = otherSigShares[1]
+			invalidSigShares[40] = sigShares[41]
+			invalidSigShares[62] = otherSigShares[2]
+
+			aggSig, err := faultAttributionSigner.AggregateAndVerifySignatures(suite, invalidSigShares, pubKeys, msg)
+			requireInvalidShares(t, []int{3, 4, 40, 62}, aggSig, err)
+		})
+		t.Run(name+": malformed shares should be found", func(t *testing.T) {
+			invalidSigShares := append([][]byte{}, sigShares...)
+			invalidSigShares[5] = (&bls.Sign{}).Serialize()
+			invalidSigShares[20] = otherSigShares[0]
+			invalidSigShares[33] = nil
+			invalidSigShares[50] = []byte("invalid signature")
+
+			aggSig, err := faultAttributionSigner.AggregateAndVerifySignatures(suite, invalidSigShares, pubKeys, msg)
+			requireInvalidShares(t, []int{5, 20, 33, 50}, aggSig, err)
+		})
+		t.Run(name+": all invalid shares should be found", func(t *testing.T) {
+			aggSig, err := faultAttributionSigner.AggregateAndVerifySignatures(suite, otherSigShares, pubKeys[:4], msg)
+			requireInvalidShares(t, []int{0, 1, 2, 3}, aggSig, err)
+		})
+		t.Run(name+": shares cancelling each other out should be found", func(t *testing.T) {
+			// sig_a + X and sig_b - X aggregate, without the rogue key coefficients, as sig_a + sig_b
+			offset := &bls.G1{}
+			_ = offset.HashAndMapTo([]byte("offset"))
+
+			sigA, _ := sigBytesToPointG1(sigShares[10])
+			sigB, _ := sigBytesToPointG1(sigShares[11])
+			bls.G1Add(sigA.G1, sigA.G1, offset)
+			bls.G1Sub(sigB.G1, sigB.G1, offset)
+
+			invalidSigShares := append([][]byte{}, sigShares...)
+			invalidSigShares[10] = sigA.G1.Serialize()
+			invalidSigShares[11] = sigB.G1.Serialize()
+
+			aggSig, err := faultAttributionSigner.AggregateAndVerifySignatures(suite, invalidSigShares, pubKeys, msg)
+			if err == nil {
+				// the aggregated signature is valid, so the shares did not poison it
+				require.Nil(t, llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, msg))
+				return
+			}
+			requireInvalidShares(t, []int{10, 11}, aggSig, err)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ crypto.LowLevelSignerBLS = (*BlsMultiSignerKOSK)(nil)
+var _ crypto.LowLevelPubKeysAggregatorBLS = (*BlsMultiSignerKOSK)(nil)
 
 // BlsMultiSignerKOSK provides an implementation of the crypto.LowLevelSignerBLS interface
 type BlsMultiSignerKOSK struct {
@@ -57,22 +58,23 @@ func (bms *BlsMultiSignerKOSK) AggregateSignatures(
 		return nil, crypto.ErrInvalidSuite
 	}
 
-	var err error
-	var sigBLS *bls.Sign
-	sigsBLS := make([]bls.Sign, 0, len(signatures))
+	// the shares are decoded one by one in the same point and added in place to the aggregation, so the aggregation
+	// does not allocate for each signer
+	aggSig := &mcl.PointG1{G1: &bls.G1{}}
+	sigPoint := &mcl.PointG1{G1: &bls.G1{}}
 	for _, sig := range signatures {
-		sigBLS, err = sigBytesToSig(sig)
+		err := sigBytesIntoPoint(sig, sigPoint.G1)
 		if err != nil {
 			return nil, err
 		}
 
-		sigsBLS = append(sigsBLS, *sigBLS)
+		err = aggSig.AddAssign(sigPoint)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	aggSigBLS := &bls.Sign{}
-	aggSigBLS.Aggregate(sigsBLS)
-
-	return aggSigBLS.Serialize(), nil
+	return aggSig.G1.Serialize(), nil
 }
 
 // VerifyAggregatedSig verifies if a BLS aggregated signature is valid over a given message
@@ -111,12 +113,59 @@ func (bms *BlsMultiSignerKOSK) VerifyAggregatedSig(
 		return err
 	}
 
-	res := aggSig.FastAggregateVerify(pubKeysBLS, msg)
-	if !res {
-		return crypto.ErrAggSigNotValid
+	return fastAggregateVerify(&bms.BlsSingleSigner, aggSig, pubKeysBLS, msg)
+}
+
+// AggregatePublicKeys aggregates the public keys into one public key, as the sum of the public keys
+func (bms *BlsMultiSignerKOSK) AggregatePublicKeys(suite crypto.Suite, pubKeys []crypto.PublicKey) (crypto.PublicKey, error) {
+	if check.IfNil(suite) {
+		return nil, crypto.ErrNilSuite
+	}
+	if len(pubKeys) == 0 {
+		return nil, crypto.ErrNilPublicKeys
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return nil, crypto.ErrInvalidSuite
+	}
+
+	pubKeysG2, err := pubKeysCryptoToValidG2(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeysBLS := make([]bls.PublicKey, len(pubKeysG2))
+	for i := range pubKeysG2 {
+		pubKeysBLS[i] = *bls.CastToPublicKey(&pubKeysG2[i])
+	}
+
+	return aggregatedPubKeyFromPoints(suite, pubKeysBLS)
+}
+
+// VerifyAggregatedSigWithAggregatedPubKey verifies a BLS aggregated signature over a given message
+// against a public key previously aggregated with AggregatePublicKeys
+func (bms *BlsMultiSignerKOSK) VerifyAggregatedSigWithAggregatedPubKey(
+	suite crypto.Suite,
+	aggPubKey crypto.PublicKey,
+	aggSigBytes []byte,
+	msg []byte,
+) error {
+	return verifyWithAggregatedPubKey(&bms.BlsSingleSigner, suite, aggPubKey, aggSigBytes, msg)
+}
+
+// RegisterAggregatedPubKey registers the aggregated public key of the given signers in the pairing lines cache, so
+// that the verifications of the signatures aggregated by exactly this set of signers use its precomputed lines
+func (bms *BlsMultiSignerKOSK) RegisterAggregatedPubKey(suite crypto.Suite, pubKeys []crypto.PublicKey) error {
+	if check.IfNil(bms.PairingLines) {
+		return crypto.ErrNilPairingLinesCache
+	}
+
+	aggPubKey, err := bms.AggregatePublicKeys(suite, pubKeys)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return registerPubKey(bms.PairingLines, aggPubKey)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -170,7 +170,30 @@ func TestBlsMultiSignerKOSK_AggregateSignatures(t *testing.T) {
 		sigAgg, err := llSig.AggregateSignatures(pubKeys[0].Suite(), sigShares, pubKeys)
 		require.Nil(t, err)
 		require.NotNil(t, sigAgg)
+
+		sigsBLS := make([]bls.Sign, len(sigShares))
+		for i := range sigShares {
+			require.Nil(t, sigsBLS[i].Deserialize(sigShares[i]))
+		}
+		expectedAggSig := &bls.Sign{}
+		expectedAggSig.Aggregate(sigsBLS)
+		require.Equal(t, expectedAggSig.Serialize(), sigAgg)
+	})
+}
+
+func TestBlsMultiSignerKOSK_AggregateSignaturesAllocationsShouldNotDependOnSigners(t *testing.T) {
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSignerKOSK{}
+	pubKeys, sigShares := createSigSharesBLS(20, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	allocsFewSigners := testing.AllocsPerRun(10, func() {
+		_, _ = llSig.AggregateSignatures(suite, sigShares[:2], pubKeys[:2])
 	})
+	allocsAllSigners := testing.AllocsPerRun(10, func() {
+		_, _ = llSig.AggregateSignatures(suite, sigShares, pubKeys)
+	})
+	require.Equal(t, allocsFewSigners, allocsAllSigners)
 }
 
 func TestBlsMultiSignerKOSK_VerifyAggregatedSig(t *testing.T) {
@@ -226,3 +249,88 @@ func genSigParamsKOSK() (
 
 	return privKey, pubKey, kg, llSigner
 }
+
+func TestBlsMultiSignerKOSK_AggregatePublicKeys(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSignerKOSK{}
+	pubKeys, sigShares := createSigSharesBLS(20, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	t.Run("nil suite should err", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(nil, pubKeys)
+		require.Equal(t, crypto.ErrNilSuite, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("invalid suite should err", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(createMockSuite("invalid suite"), pubKeys)
+		require.Equal(t, crypto.ErrInvalidSuite, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("empty pub keys should err", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(suite, nil)
+		require.Equal(t, crypto.ErrNilPublicKeys, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("nil pub key should err", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(suite, []crypto.PublicKey{pubKeys[0], nil})
+		require.Equal(t, crypto.ErrNilPublicKey, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("keys summing to zero should err", func(t *testing.T) {
+		kg := signing.NewKeyGenerator(suite)
+		negPoint := pubKeys[0].Point().Neg()
+		negPointBytes, _ := negPoint.MarshalBinary()
+		negPubKey, _ := kg.PublicKeyFromByteArray(negPointBytes)
+
+		aggPubKey, err := llSig.AggregatePublicKeys(suite, []crypto.PublicKey{pubKeys[0], negPubKey})
+		require.Equal(t, crypto.ErrInvalidPublicKey, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("should work", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(suite, pubKeys)
+		require.Nil(t, err)
+
+		aggSig, err := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+		require.Nil(t, err)
+
+		err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, aggPubKey, aggSig, msg)
+		require.Nil(t, err)
+
+		err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, aggPubKey, aggSig, []byte("other message"))
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+
+		err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, pubKeys[0], aggSig, msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+}
+
+func TestBlsMultiSignerKOSK_VerifyAggregatedSigWithAggregatedPubKey(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSignerKOSK{}
+	pubKeys, sigShares := createSigSharesBLS(5, msg, llSig)
+	suite := pubKeys[0].Suite()
+	aggPubKey, _ := llSig.AggregatePublicKeys(suite, pubKeys)
+	aggSig, _ := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+
+	err := llSig.VerifyAggregatedSigWithAggregatedPubKey(nil, aggPubKey, aggSig, msg)
+	require.Equal(t, crypto.ErrNilSuite, err)
+
+	err = llSig.VerifyAggregatedSigWithAggregatedPubKey(createMockSuite("invalid suite"), aggPubKey, aggSig, msg)
+	require.Equal(t, crypto.ErrInvalidSuite, err)
+
+	err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, nil, aggSig, msg)
+	require.Equal(t, crypto.ErrNilPublicKey, err)
+
+	err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, aggPubKey, nil, msg)
+	require.Equal(t, crypto.ErrNilSignature, err)
+
+	err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, aggPubKey, aggSig, nil)
+	require.Equal(t, crypto.ErrNilMessage, err)
+
+	err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, aggPubKey, aggSig, msg)
+	require.Nil(t, err)
+}
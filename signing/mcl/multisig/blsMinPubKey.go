@@ -0,0 +1,237 @@
+package multisig
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+/*
+The "minimal public key" variant of the modified BLS multi-signature scheme, with the public keys on G1 and the
+signatures on G2, to be used with mcl.SuiteBLS12MinPubKey. The rogue key protection is the same as for BlsMultiSigner:
+
+	aggSig = sum(t_i*sig_i), aggPk = sum(t_i*pk_i), where t_i = H1(pk_i, {pk_1, ..., pk_n})
+
+and the aggregated signature is verified as a single signature of aggPk over the message.
+*/
+
+var _ crypto.LowLevelSignerBLS = (*BlsMinPubKeyMultiSigner)(nil)
+
+// BlsMinPubKeyMultiSigner provides an implementation of the crypto.LowLevelSignerBLS interface for the
+// "minimal public key" BLS variant, protected against rogue key attacks with the public keys coefficients
+type BlsMinPubKeyMultiSigner struct {
+	singlesig.BlsMinPubKeySigner
+	Hasher hashing.Hasher
+}
+
+// SignShare produces a BLS signature share (single BLS signature) over a given message
+func (bms *BlsMinPubKeyMultiSigner) SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error) {
+	return bms.Sign(privKey, message)
+}
+
+// VerifySigShare verifies a BLS signature share (single BLS signature) over a given message
+func (bms *BlsMinPubKeyMultiSigner) VerifySigShare(pubKey crypto.PublicKey, message []byte, sig []byte) error {
+	return bms.Verify(pubKey, message, sig)
+}
+
+// VerifySigBytes provides an "cheap" integrity check of a signature given as a byte array
+// It does not validate the signature over a message, only verifies that it is a signature
+func (bms *BlsMinPubKeyMultiSigner) VerifySigBytes(_ crypto.Suite, sig []byte) error {
+	_, err := singlesig.MinPubKeySigBytesToG2(sig)
+
+	return err
+}
+
+// AggregateSignatures produces an aggregation of single BLS signatures over the same message
+func (bms *BlsMinPubKeyMultiSigner) AggregateSignatures(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+) ([]byte, error) {
+	err := checkMinPubKeyAggregationArgs(suite, signatures, pubKeysSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	coefficients, err := minPubKeysCoefficients(bms.Hasher, suite, pubKeysSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	sigsPoints := make([]*mcl.PointG2, len(signatures))
+	coefficientsScalars := make([]*mcl.Scalar, len(signatures))
+	for i, sig := range signatures {
+		sigG2, errConvert := singlesig.MinPubKeySigBytesToG2(sig)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		sigsPoints[i] = &mcl.PointG2{G2: sigG2}
+		coefficientsScalars[i] = &mcl.Scalar{Scalar: &coefficients[i]}
+	}
+
+	// sum(H1(pubKey_i)*sig_i)
+	aggSig, err := mcl.MultiScalarMulG2(sigsPoints, coefficientsScalars)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggSig.G2.Serialize(), nil
+}
+
+// VerifyAggregatedSig verifies if a BLS aggregated signature is valid over a given message
+func (bms *BlsMinPubKeyMultiSigner) VerifyAggregatedSig(
+	suite crypto.Suite,
+	pubKeys []crypto.PublicKey,
+	aggSigBytes []byte,
+	msg []byte,
+) error {
+	err := checkMinPubKeyVerifyArgs(suite, pubKeys, aggSigBytes, msg)
+	if err != nil {
+		return err
+	}
+
+	pubKeysG1, err := minPubKeysToValidG1(pubKeys)
+	if err != nil {
+		return err
+	}
+
+	coefficients, err := minPubKeysCoefficients(bms.Hasher, suite, pubKeys)
+	if err != nil {
+		return err
+	}
+
+	// sum(t_i*pubKey_i)
+	aggPubKey := &bls.G1{}
+	bls.G1MulVec(aggPubKey, pubKeysG1, coefficients)
+
+	return verifyMinPubKeyAggregatedSig(&bms.BlsMinPubKeySigner, aggPubKey, aggSigBytes, msg)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bms *BlsMinPubKeyMultiSigner) IsInterfaceNil() bool {
+	return bms == nil
+}
+
+// minPubKeysCoefficients returns the rogue key coefficients t_i = H1(pk_i, {pk_1, ..., pk_n}) of the public keys
+func minPubKeysCoefficients(hasher hashing.Hasher, suite crypto.Suite, pubKeys []crypto.PublicKey) ([]bls.Fr, error) {
+	concatPKs, err := concatPubKeys(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	coefficients := make([]bls.Fr, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		hPk, errHash := hashPublicKeyPoints(hasher, pubKey.Point(), concatPKs)
+		if errHash != nil {
+			return nil, errHash
+		}
+
+		scalar, errScalar := createScalar(suite, hPk)
+		if errScalar != nil {
+			return nil, errScalar
+		}
+
+		coefficients[i] = *scalar.(*mcl.Scalar).Scalar
+	}
+
+	return coefficients, nil
+}
+
+// minPubKeysToValidG1 returns the G1 points of the given "minimal public key" public keys, checking that they are
+// valid public keys
+func minPubKeysToValidG1(pubKeys []crypto.PublicKey) ([]bls.G1, error) {
+	pubKeysG1 := make([]bls.G1, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if check.IfNil(pubKey) {
+			return nil, crypto.ErrNilPublicKey
+		}
+
+		pubKeyPoint := pubKey.Point()
+		if check.IfNil(pubKeyPoint) {
+			return nil, crypto.ErrNilPublicKeyPoint
+		}
+
+		mclPointG1, isPoint := pubKeyPoint.(*mcl.PointG1)
+		if !isPoint || !singlesig.IsMinPubKeyPointValid(mclPointG1) {
+			return nil, crypto.ErrInvalidPublicKey
+		}
+
+		pubKeysG1 = append(pubKeysG1, *mclPointG1.G1)
+	}
+
+	return pubKeysG1, nil
+}
+
+func checkMinPubKeyAggregationArgs(suite crypto.Suite, signatures [][]byte, pubKeysSigners []crypto.PublicKey) error {
+	if check.IfNil(suite) {
+		return crypto.ErrNilSuite
+	}
+	if len(signatures) == 0 {
+		return crypto.ErrNilSignaturesList
+	}
+	if len(pubKeysSigners) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+	if len(signatures) != len(pubKeysSigners) {
+		return crypto.ErrInvalidParam
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12MinPubKey)
+	if !ok {
+		return crypto.ErrInvalidSuite
+	}
+
+	return nil
+}
+
+func checkMinPubKeyVerifyArgs(suite crypto.Suite, pubKeys []crypto.PublicKey, aggSigBytes []byte, msg []byte) error {
+	if check.IfNil(suite) {
+		return crypto.ErrNilSuite
+	}
+	if len(pubKeys) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+	if len(aggSigBytes) == 0 {
+		return crypto.ErrNilSignature
+	}
+	if len(msg) == 0 {
+		return crypto.ErrNilMessage
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12MinPubKey)
+	if !ok {
+		return crypto.ErrInvalidSuite
+	}
+
+	return nil
+}
+
+// verifyMinPubKeyAggregatedSig verifies the aggregated signature over the message against the aggregated public key
+func verifyMinPubKeyAggregatedSig(
+	signer *singlesig.BlsMinPubKeySigner,
+	aggPubKey *bls.G1,
+	aggSigBytes []byte,
+	msg []byte,
+) error {
+	if aggPubKey.IsZero() {
+		return crypto.ErrAggSigNotValid
+	}
+
+	aggSig, err := singlesig.MinPubKeySigBytesToG2(aggSigBytes)
+	if err != nil {
+		return err
+	}
+
+	hashPoint, err := signer.HashToG2(msg)
+	if err != nil {
+		return err
+	}
+
+	if !singlesig.IsMinPubKeyPairingValid(aggSig, hashPoint, aggPubKey) {
+		return crypto.ErrAggSigNotValid
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package multisig
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+var _ crypto.LowLevelSignerBLS = (*BlsMinPubKeyMultiSignerKOSK)(nil)
+
+// BlsMinPubKeyMultiSignerKOSK provides an implementation of the crypto.LowLevelSignerBLS interface for the
+// "minimal public key" BLS variant, where the signers are known to own their secret keys (KOSK), so the signatures
+// and the public keys are aggregated by simple addition
+type BlsMinPubKeyMultiSignerKOSK struct {
+	singlesig.BlsMinPubKeySigner
+}
+
+// SignShare produces a BLS signature share (single BLS signature) over a given message
+func (bms *BlsMinPubKeyMultiSignerKOSK) SignShare(privKey crypto.PrivateKey, message []byte) ([]byte, error) {
+	return bms.Sign(privKey, message)
+}
+
+// VerifySigShare verifies a BLS signature share (single BLS signature) over a given message
+func (bms *BlsMinPubKeyMultiSignerKOSK) VerifySigShare(pubKey crypto.PublicKey, message []byte, sig []byte) error {
+	return bms.Verify(pubKey, message, sig)
+}
+
+// VerifySigBytes provides an "cheap" integrity check of a signature given as a byte array
+// It does not validate the signature over a message, only verifies that it is a signature
+func (bms *BlsMinPubKeyMultiSignerKOSK) VerifySigBytes(_ crypto.Suite, sig []byte) error {
+	_, err := singlesig.MinPubKeySigBytesToG2(sig)
+
+	return err
+}
+
+// AggregateSignatures produces an aggregation of single BLS signatures over the same message
+func (bms *BlsMinPubKeyMultiSignerKOSK) AggregateSignatures(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+) ([]byte, error) {
+	err := checkMinPubKeyAggregationArgs(suite, signatures, pubKeysSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	aggSig := &bls.G2{}
+	aggSig.Clear()
+	for _, sig := range signatures {
+		sigG2, errConvert := singlesig.MinPubKeySigBytesToG2(sig)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		bls.G2Add(aggSig, aggSig, sigG2)
+	}
+
+	return aggSig.Serialize(), nil
+}
+
+// VerifyAggregatedSig verifies if a BLS aggregated signature is valid over a given message
+func (bms *BlsMinPubKeyMultiSignerKOSK) VerifyAggregatedSig(
+	suite crypto.Suite,
+	pubKeys []crypto.PublicKey,
+	aggSigBytes []byte,
+	msg []byte,
+) error {
+	err := checkMinPubKeyVerifyArgs(suite, pubKeys, aggSigBytes, msg)
+	if err != nil {
+		return err
+	}
+
+	pubKeysG1, err := minPubKeysToValidG1(pubKeys)
+	if err != nil {
+		return err
+	}
+
+	aggPubKey := &bls.G1{}
+	aggPubKey.Clear()
+	for i := range pubKeysG1 {
+		bls.G1Add(aggPubKey, aggPubKey, &pubKeysG1[i])
+	}
+
+	return verifyMinPubKeyAggregatedSig(&bms.BlsMinPubKeySigner, aggPubKey, aggSigBytes, msg)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bms *BlsMinPubKeyMultiSignerKOSK) IsInterfaceNil() bool {
+	return bms == nil
+}
@@ -0,0 +1,93 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlsMinPubKeyMultiSignerKOSK_SignShareVerifySigShare(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMinPubKeyMultiSignerKOSK{}
+	pubKeys, sigShares := createMinPubKeySigShares(2, msg, llSig)
+
+	err := llSig.VerifySigShare(pubKeys[0], msg, sigShares[0])
+	require.Nil(t, err)
+
+	err = llSig.VerifySigShare(pubKeys[0], msg, sigShares[1])
+	require.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestBlsMinPubKeyMultiSignerKOSK_AggregateSignatures(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMinPubKeyMultiSignerKOSK{}
+	pubKeys, sigShares := createMinPubKeySigShares(10, msg, llSig)
+	suite := mcl.NewSuiteBLS12MinPubKey()
+
+	t.Run("nil signatures should err", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(suite, nil, pubKeys)
+		require.Equal(t, crypto.ErrNilSignaturesList, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("public keys on G2 suite should err", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(mcl.NewSuiteBLS12(), sigShares, pubKeys)
+		require.Equal(t, crypto.ErrInvalidSuite, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("should work", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+		require.Nil(t, err)
+
+		err = llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, msg)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsMinPubKeyMultiSignerKOSK_VerifyAggregatedSig(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMinPubKeyMultiSignerKOSK{}
+	pubKeys, sigShares := createMinPubKeySigShares(10, msg, llSig)
+	suite := mcl.NewSuiteBLS12MinPubKey()
+	aggSig, _ := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+
+	t.Run("nil public keys should err", func(t *testing.T) {
+		err := llSig.VerifyAggregatedSig(suite, nil, aggSig, msg)
+		require.Equal(t, crypto.ErrNilPublicKeys, err)
+	})
+	t.Run("missing signer should err", func(t *testing.T) {
+		err := llSig.VerifyAggregatedSig(suite, pubKeys[1:], aggSig, msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+	t.Run("other domain separation tag should err", func(t *testing.T) {
+		signer, _ := singlesig.NewBlsMinPubKeySignerWithDST([]byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_"))
+		llSigWithDST := &multisig.BlsMinPubKeyMultiSignerKOSK{BlsMinPubKeySigner: *signer}
+
+		err := llSigWithDST.VerifyAggregatedSig(suite, pubKeys, aggSig, msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		err := llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, msg)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsMinPubKeyMultiSignerKOSK_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var llSig *multisig.BlsMinPubKeyMultiSignerKOSK
+	require.True(t, check.IfNil(llSig))
+
+	llSig = &multisig.BlsMinPubKeyMultiSignerKOSK{}
+	require.False(t, check.IfNil(llSig))
+}
@@ -0,0 +1,136 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/mock"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/stretchr/testify/require"
+)
+
+func createMinPubKeySigShares(
+	nbSigs int,
+	message []byte,
+	llSigner crypto.LowLevelSignerBLS,
+) (pubKeys []crypto.PublicKey, sigShares [][]byte) {
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12MinPubKey())
+
+	pubKeys = make([]crypto.PublicKey, nbSigs)
+	sigShares = make([][]byte, nbSigs)
+	for i := 0; i < nbSigs; i++ {
+		sk, pk := kg.GeneratePair()
+		pubKeys[i] = pk
+		sigShares[i], _ = llSigner.SignShare(sk, message)
+	}
+
+	return pubKeys, sigShares
+}
+
+func TestBlsMinPubKeyMultiSigner_VerifySigBytes(t *testing.T) {
+	t.Parallel()
+
+	llSig := &multisig.BlsMinPubKeyMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+
+	err := llSig.VerifySigBytes(nil, nil)
+	require.Equal(t, crypto.ErrNilSignature, err)
+
+	_, sigShares := createSigSharesBLS(1, []byte(testMessage), &multisig.BlsMultiSignerKOSK{})
+	err = llSig.VerifySigBytes(nil, sigShares[0])
+	require.NotNil(t, err)
+
+	_, sigShares = createMinPubKeySigShares(1, []byte(testMessage), llSig)
+	err = llSig.VerifySigBytes(nil, sigShares[0])
+	require.Nil(t, err)
+}
+
+func TestBlsMinPubKeyMultiSigner_AggregateSignatures(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMinPubKeyMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createMinPubKeySigShares(10, msg, llSig)
+	suite := mcl.NewSuiteBLS12MinPubKey()
+
+	t.Run("nil suite should err", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(nil, sigShares, pubKeys)
+		require.Equal(t, crypto.ErrNilSuite, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("public keys on G2 suite should err", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(mcl.NewSuiteBLS12(), sigShares, pubKeys)
+		require.Equal(t, crypto.ErrInvalidSuite, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("different number of signatures and public keys should err", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(suite, sigShares[1:], pubKeys)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("invalid signature should err", func(t *testing.T) {
+		invalidSigShares := append([][]byte{[]byte("invalid")}, sigShares[1:]...)
+		aggSig, err := llSig.AggregateSignatures(suite, invalidSigShares, pubKeys)
+		require.NotNil(t, err)
+		require.Nil(t, aggSig)
+	})
+	t.Run("should work", func(t *testing.T) {
+		aggSig, err := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+		require.Nil(t, err)
+
+		err = llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, msg)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsMinPubKeyMultiSigner_VerifyAggregatedSig(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMinPubKeyMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createMinPubKeySigShares(10, msg, llSig)
+	suite := mcl.NewSuiteBLS12MinPubKey()
+	aggSig, _ := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+
+	t.Run("nil message should err", func(t *testing.T) {
+		err := llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, nil)
+		require.Equal(t, crypto.ErrNilMessage, err)
+	})
+	t.Run("public keys on G2 should err", func(t *testing.T) {
+		pubKeysG2, _ := createSigSharesBLS(10, msg, &multisig.BlsMultiSignerKOSK{})
+		err := llSig.VerifyAggregatedSig(suite, pubKeysG2, aggSig, msg)
+		require.Equal(t, crypto.ErrInvalidPublicKey, err)
+	})
+	t.Run("missing signer should err", func(t *testing.T) {
+		err := llSig.VerifyAggregatedSig(suite, pubKeys[1:], aggSig, msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+	t.Run("signatures aggregated without coefficients should err", func(t *testing.T) {
+		kosk := &multisig.BlsMinPubKeyMultiSignerKOSK{}
+		plainAggSig, err := kosk.AggregateSignatures(suite, sigShares, pubKeys)
+		require.Nil(t, err)
+
+		err = llSig.VerifyAggregatedSig(suite, pubKeys, plainAggSig, msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+	t.Run("other message should err", func(t *testing.T) {
+		err := llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, []byte("other message"))
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		err := llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, msg)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsMinPubKeyMultiSigner_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var llSig *multisig.BlsMinPubKeyMultiSigner
+	require.True(t, check.IfNil(llSig))
+
+	llSig = &multisig.BlsMinPubKeyMultiSigner{}
+	require.False(t, check.IfNil(llSig))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/herumi/bls-go-binary/bls"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
 )
@@ -74,20 +75,32 @@ func sigBytesToPoint(sig []byte) (crypto.Point, error) {
 }
 
 func sigBytesToSig(sig []byte) (*bls.Sign, error) {
-	sigBLS := &bls.Sign{}
+	sigPoint := &bls.G1{}
+	err := sigBytesIntoPoint(sig, sigPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.CastToSign(sigPoint), nil
+}
+
+// sigBytesIntoPoint deserializes the BLS signature into the given point, without allocating, and checks it is a valid
+// signature point
+func sigBytesIntoPoint(sig []byte, sigPoint *bls.G1) error {
 	if len(sig) == 0 {
-		return nil, crypto.ErrNilSignature
+		return crypto.ErrNilSignature
 	}
+	sigBLS := bls.CastToSign(sigPoint)
 	err := sigBLS.Deserialize(sig)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if !singlesig.IsSigValidPoint(sigBLS) {
-		return nil, crypto.ErrBLSInvalidSignature
+		return crypto.ErrBLSInvalidSignature
 	}
 
-	return sigBLS, nil
+	return nil
 }
 
 func pubKeysCryptoToBLS(pubKeys []crypto.PublicKey) ([]bls.PublicKey, error) {
@@ -132,3 +145,146 @@ func createScalar(suite crypto.Suite, scalarBytes []byte) (crypto.Scalar, error)
 
 	return scalar, nil
 }
+
+// pubKeysCryptoToValidG2 returns the G2 points of the given public keys, checking that they are valid public keys
+func pubKeysCryptoToValidG2(pubKeys []crypto.PublicKey) ([]bls.G2, error) {
+	pubKeysG2 := make([]bls.G2, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if check.IfNil(pubKey) {
+			return nil, crypto.ErrNilPublicKey
+		}
+
+		pubKeyPoint := pubKey.Point()
+		if check.IfNil(pubKeyPoint) {
+			return nil, crypto.ErrNilPublicKeyPoint
+		}
+
+		mclPointG2, isPoint := pubKeyPoint.(*mcl.PointG2)
+		if !isPoint || !singlesig.IsPubKeyValid(pubKey, mclPointG2) {
+			return nil, crypto.ErrInvalidPublicKey
+		}
+
+		pubKeysG2 = append(pubKeysG2, *mclPointG2.G2)
+	}
+
+	return pubKeysG2, nil
+}
+
+// verifyDistinctMessagesPairing checks e(aggSig, g2) == prod(e(H(m_i), pk_i)) with a single final exponentiation,
+// by verifying that e(aggSig, -g2) * prod(e(H(m_i), pk_i)) is the identity. The messages are hashed with the hashing
+// configured for the signer
+func verifyDistinctMessagesPairing(
+	signer *singlesig.BlsSingleSigner,
+	aggSig *bls.G1,
+	pubKeysG2 []bls.G2,
+	messages [][]byte,
+) (bool, error) {
+	hashesG1 := make([]bls.G1, len(messages)+1)
+	pointsG2 := make([]bls.G2, len(pubKeysG2)+1)
+
+	hashesG1[0] = *aggSig
+	bls.G2Neg(&pointsG2[0], mcl.NewPointG2().G2)
+	copy(pointsG2[1:], pubKeysG2)
+
+	for i, msg := range messages {
+		hashPoint, err := signer.HashToG1(msg)
+		if err != nil {
+			return false, err
+		}
+
+		hashesG1[i+1] = *hashPoint
+	}
+
+	return mcl.IsPairingProductOne(hashesG1, pointsG2), nil
+}
+
+// fastAggregateVerify verifies the aggregated signature over the message against the sum of the public keys. The
+// message is hashed with the hashing configured for the signer, and the pairing lines cache of the signer is used if
+// set: the lines of the generator are always reused, while the ones of the sum of the public keys only if it was
+// registered, as with RegisterAggregatedPubKey
+func fastAggregateVerify(signer *singlesig.BlsSingleSigner, aggSig *bls.Sign, pubKeys []bls.PublicKey, msg []byte) error {
+	aggPubKey := &bls.PublicKey{}
+	for i := range pubKeys {
+		aggPubKey.Add(&pubKeys[i])
+	}
+	if aggPubKey.IsZero() {
+		return crypto.ErrAggSigNotValid
+	}
+
+	hashPoint, err := signer.HashToG1(msg)
+	if err != nil {
+		return err
+	}
+
+	if check.IfNil(signer.PairingLines) {
+		if !singlesig.IsPairingValid(bls.CastFromSign(aggSig), hashPoint, bls.CastFromPublicKey(aggPubKey)) {
+			return crypto.ErrAggSigNotValid
+		}
+
+		return nil
+	}
+
+	isValid, err := signer.PairingLines.VerifyPairing(
+		&mcl.PointG1{G1: bls.CastFromSign(aggSig)},
+		&mcl.PointG1{G1: hashPoint},
+		&mcl.PointG2{G2: bls.CastFromPublicKey(aggPubKey)},
+	)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return crypto.ErrAggSigNotValid
+	}
+
+	return nil
+}
+
+// registerPubKey registers the public key in the pairing lines cache
+func registerPubKey(pairingLines *mcl.PairingLinesCache, pubKey crypto.PublicKey) error {
+	pubKeyPoint, ok := pubKey.Point().(*mcl.PointG2)
+	if !ok {
+		return crypto.ErrInvalidPoint
+	}
+
+	return pairingLines.Register(pubKeyPoint)
+}
+
+// aggregatedPubKeyFromPoints sums the given public key points and returns the result as a public key
+func aggregatedPubKeyFromPoints(suite crypto.Suite, pubKeys []bls.PublicKey) (crypto.PublicKey, error) {
+	aggPubKey := &bls.PublicKey{}
+	for i := range pubKeys {
+		aggPubKey.Add(&pubKeys[i])
+	}
+
+	if aggPubKey.IsZero() {
+		return nil, crypto.ErrInvalidPublicKey
+	}
+
+	kg := signing.NewKeyGenerator(suite)
+
+	return kg.PublicKeyFromByteArray(aggPubKey.Serialize())
+}
+
+// verifyWithAggregatedPubKey verifies the aggregated signature over the message against the aggregated public key
+func verifyWithAggregatedPubKey(
+	signer *singlesig.BlsSingleSigner,
+	suite crypto.Suite,
+	aggPubKey crypto.PublicKey,
+	aggSigBytes []byte,
+	msg []byte,
+) error {
+	if check.IfNil(suite) {
+		return crypto.ErrNilSuite
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return crypto.ErrInvalidSuite
+	}
+
+	err := signer.Verify(aggPubKey, msg, aggSigBytes)
+	if err == crypto.ErrSigNotValid {
+		return crypto.ErrAggSigNotValid
+	}
+
+	return err
+}
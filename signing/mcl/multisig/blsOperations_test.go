@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
 	"github.com/stretchr/testify/require"
 )
 
@@ -329,3 +330,116 @@ func Test_PubKeysCryptoToBLS(t *testing.T) {
 		require.Len(t, pubKeysBLS, 2)
 	})
 }
+
+func Test_FastAggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSignerKOSK{}
+	pubKeys, sigShares := createSigSharesBLS(5, msg, llSig)
+	aggSigBytes, _ := llSig.AggregateSignatures(pubKeys[0].Suite(), sigShares, pubKeys)
+	aggSig, _ := multisig.SigBytesToSig(aggSigBytes)
+	pubKeysBLS, _ := multisig.PubKeysCryptoToBLS(pubKeys)
+	pairingLines, _ := mcl.NewPairingLinesCache(10)
+
+	for _, cache := range []*mcl.PairingLinesCache{nil, pairingLines} {
+		signer := &singlesig.BlsSingleSigner{PairingLines: cache}
+		err := multisig.FastAggregateVerify(signer, aggSig, pubKeysBLS, msg)
+		require.Nil(t, err)
+
+		err = multisig.FastAggregateVerify(signer, aggSig, pubKeysBLS[1:], msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+
+		err = multisig.FastAggregateVerify(signer, aggSig, pubKeysBLS, []byte("other message"))
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	}
+
+	t.Run("zero aggregated public key should err", func(t *testing.T) {
+		negatedPubKey := &bls.G2{}
+		bls.G2Neg(negatedPubKey, bls.CastFromPublicKey(&pubKeysBLS[0]))
+		zeroSumPubKeys := []bls.PublicKey{pubKeysBLS[0], *bls.CastToPublicKey(negatedPubKey)}
+
+		zeroSig := &bls.Sign{}
+		err := multisig.FastAggregateVerify(&singlesig.BlsSingleSigner{PairingLines: pairingLines}, zeroSig, zeroSumPubKeys, msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+}
+
+func TestBlsMultiSigners_VerifyWithPairingLines(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	llSig.PairingLines, _ = mcl.NewPairingLinesCache(30)
+	llSigKOSK := &multisig.BlsMultiSignerKOSK{}
+	llSigKOSK.PairingLines = llSig.PairingLines
+
+	pubKeys, sigShares := createSigSharesBLS(20, msg, llSig)
+	suite := pubKeys[0].Suite()
+	for _, pubKey := range pubKeys {
+		require.Nil(t, llSig.PairingLines.Register(pubKey.Point().(*mcl.PointG2)))
+	}
+
+	for i := range pubKeys {
+		require.Nil(t, llSig.VerifySigShare(pubKeys[i], msg, sigShares[i]))
+		require.Equal(t, crypto.ErrSigNotValid, llSigKOSK.VerifySigShare(pubKeys[i], msg, sigShares[(i+1)%len(sigShares)]))
+	}
+
+	type pubKeysAggregatorSigner interface {
+		crypto.LowLevelSignerBLS
+		crypto.LowLevelPubKeysAggregatorBLS
+		RegisterAggregatedPubKey(suite crypto.Suite, pubKeys []crypto.PublicKey) error
+	}
+
+	for _, signer := range []pubKeysAggregatorSigner{llSig, llSigKOSK} {
+		aggSig, err := signer.AggregateSignatures(suite, sigShares, pubKeys)
+		require.Nil(t, err)
+		require.Nil(t, signer.VerifyAggregatedSig(suite, pubKeys, aggSig, msg))
+		require.Equal(t, crypto.ErrAggSigNotValid, signer.VerifyAggregatedSig(suite, pubKeys, aggSig, []byte("other message")))
+		require.Equal(t, crypto.ErrAggSigNotValid, signer.VerifyAggregatedSig(suite, pubKeys, sigShares[0], msg))
+
+		numCachedKeys := llSig.PairingLines.Len()
+		require.Nil(t, signer.RegisterAggregatedPubKey(suite, pubKeys))
+		require.Equal(t, numCachedKeys+1, llSig.PairingLines.Len())
+		require.Nil(t, signer.VerifyAggregatedSig(suite, pubKeys, aggSig, msg))
+		require.Equal(t, crypto.ErrAggSigNotValid, signer.VerifyAggregatedSig(suite, pubKeys, aggSig, []byte("other message")))
+
+		aggPubKey, err := signer.AggregatePublicKeys(suite, pubKeys)
+		require.Nil(t, err)
+		require.Nil(t, signer.VerifyAggregatedSigWithAggregatedPubKey(suite, aggPubKey, aggSig, msg))
+	}
+}
+
+func TestBlsMultiSigners_RegisterAggregatedPubKey(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	llSigKOSK := &multisig.BlsMultiSignerKOSK{}
+	pubKeys, _ := createSigSharesBLS(5, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	t.Run("nil pairing lines cache should err", func(t *testing.T) {
+		require.Equal(t, crypto.ErrNilPairingLinesCache, llSig.RegisterAggregatedPubKey(suite, pubKeys))
+		require.Equal(t, crypto.ErrNilPairingLinesCache, llSigKOSK.RegisterAggregatedPubKey(suite, pubKeys))
+	})
+	t.Run("nil public keys should err", func(t *testing.T) {
+		pairingLines, _ := mcl.NewPairingLinesCache(10)
+		signer := &multisig.BlsMultiSignerKOSK{}
+		signer.PairingLines = pairingLines
+
+		require.Equal(t, crypto.ErrNilPublicKeys, signer.RegisterAggregatedPubKey(suite, nil))
+		require.Equal(t, 0, pairingLines.Len())
+	})
+	t.Run("aggregated public keys of different signers should be different entries", func(t *testing.T) {
+		pairingLines, _ := mcl.NewPairingLinesCache(10)
+		signer := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+		signer.PairingLines = pairingLines
+
+		require.Nil(t, signer.RegisterAggregatedPubKey(suite, pubKeys))
+		require.Nil(t, signer.RegisterAggregatedPubKey(suite, pubKeys))
+		require.Equal(t, 1, pairingLines.Len())
+		require.Nil(t, signer.RegisterAggregatedPubKey(suite, pubKeys[1:]))
+		require.Equal(t, 2, pairingLines.Len())
+	})
+}
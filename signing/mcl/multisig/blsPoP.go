@@ -0,0 +1,117 @@
+package multisig
+
+import (
+	"runtime"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+/*
+The proof of possession (PoP) of a BLS secret key sk, with the public key pk = sk*g2, is a signature over pk:
+PoP = sk*H_pop(pk), verified as e(PoP, g2) == e(H_pop(pk), pk)
+
+H_pop is a hash to G1 that uses its own domain separation tag, different from the hashing used for the regular
+signatures, so that a PoP can never be obtained by asking the key owner to sign a message, and a regular signature
+can never be accepted as a PoP.
+
+The public key is hashed to G1 with the BLS12381G1_XMD:SHA-256_SSWU_RO_ suite of RFC 9380 and the PoP domain
+separation tag. The proofs are not interoperable with the IETF BLS proof of possession ciphersuite: the hashed public
+key is in the herumi serialization instead of the ZCash one, and the keys are generated from the herumi G2 generator
+instead of the standard one.
+*/
+
+// PoPDomainSeparationTag is the domain separation tag used when hashing the public keys for the proofs of possession
+const PoPDomainSeparationTag = "BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_"
+
+var _ crypto.LowLevelPoPSignerBLS = (*BlsMultiSignerKOSK)(nil)
+
+// CreatePoP creates the proof of possession for the given private key
+func (bms *BlsMultiSignerKOSK) CreatePoP(privKey crypto.PrivateKey) ([]byte, error) {
+	return createPoP(privKey)
+}
+
+// VerifyPoP verifies the proof of possession of the secret key corresponding to the given public key
+func (bms *BlsMultiSignerKOSK) VerifyPoP(pubKey crypto.PublicKey, pop []byte) error {
+	return verifyPoP(pubKey, pop)
+}
+
+func createPoP(privKey crypto.PrivateKey) ([]byte, error) {
+	if check.IfNil(privKey) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+
+	scalar := privKey.Scalar()
+	if check.IfNil(scalar) {
+		return nil, crypto.ErrNilPrivateKeyScalar
+	}
+
+	mclScalar, ok := scalar.(*mcl.Scalar)
+	if !ok || !singlesig.IsSecretKeyValid(mclScalar) {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	pubKey := privKey.GeneratePublic()
+	if check.IfNil(pubKey) {
+		return nil, crypto.ErrGeneratingPubFromPriv
+	}
+
+	pubKeyBytes, err := pubKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	hashPoint, err := hashPubKeyToG1(pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	popPoint := &bls.G1{}
+	bls.G1MulCT(popPoint, hashPoint, mclScalar.Scalar)
+	runtime.KeepAlive(mclScalar)
+
+	return popPoint.Serialize(), nil
+}
+
+func verifyPoP(pubKey crypto.PublicKey, pop []byte) error {
+	if check.IfNil(pubKey) {
+		return crypto.ErrNilPublicKey
+	}
+	if len(pop) == 0 {
+		return crypto.ErrNilSignature
+	}
+
+	pubKeysG2, err := pubKeysCryptoToValidG2([]crypto.PublicKey{pubKey})
+	if err != nil {
+		return err
+	}
+
+	popSig, err := sigBytesToSig(pop)
+	if err != nil {
+		return err
+	}
+
+	pubKeyBytes, err := pubKey.ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	hashPoint, err := hashPubKeyToG1(pubKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	if !singlesig.IsPairingValid(bls.CastFromSign(popSig), hashPoint, &pubKeysG2[0]) {
+		return crypto.ErrPoPNotValid
+	}
+
+	return nil
+}
+
+// hashPubKeyToG1 hashes the public key bytes to a point on G1, using the PoP domain separation tag
+func hashPubKeyToG1(pubKeyBytes []byte) (*bls.G1, error) {
+	return mcl.HashToG1(pubKeyBytes, []byte(PoPDomainSeparationTag))
+}
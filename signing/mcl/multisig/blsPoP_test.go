@@ -0,0 +1,95 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/mock"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlsMultiSignerKOSK_CreatePoP(t *testing.T) {
+	t.Parallel()
+
+	sk, pk, _, _ := genSigParamsKOSK()
+	llSig := &multisig.BlsMultiSignerKOSK{}
+
+	t.Run("nil private key should err", func(t *testing.T) {
+		pop, err := llSig.CreatePoP(nil)
+		require.Equal(t, crypto.ErrNilPrivateKey, err)
+		require.Nil(t, pop)
+	})
+	t.Run("nil scalar should err", func(t *testing.T) {
+		privKey := &mock.PrivateKeyStub{
+			ScalarStub: func() crypto.Scalar {
+				return nil
+			},
+		}
+		pop, err := llSig.CreatePoP(privKey)
+		require.Equal(t, crypto.ErrNilPrivateKeyScalar, err)
+		require.Nil(t, pop)
+	})
+	t.Run("invalid private key should err", func(t *testing.T) {
+		privKey := &mock.PrivateKeyStub{
+			ScalarStub: func() crypto.Scalar {
+				return &mock.ScalarMock{}
+			},
+		}
+		pop, err := llSig.CreatePoP(privKey)
+		require.Equal(t, crypto.ErrInvalidPrivateKey, err)
+		require.Nil(t, pop)
+	})
+	t.Run("should work", func(t *testing.T) {
+		pop, err := llSig.CreatePoP(sk)
+		require.Nil(t, err)
+		require.NotNil(t, pop)
+
+		err = llSig.VerifyPoP(pk, pop)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsMultiSignerKOSK_VerifyPoP(t *testing.T) {
+	t.Parallel()
+
+	sk, pk, kg, _ := genSigParamsKOSK()
+	llSig := &multisig.BlsMultiSignerKOSK{}
+	pop, err := llSig.CreatePoP(sk)
+	require.Nil(t, err)
+
+	t.Run("nil public key should err", func(t *testing.T) {
+		err = llSig.VerifyPoP(nil, pop)
+		require.Equal(t, crypto.ErrNilPublicKey, err)
+	})
+	t.Run("empty pop should err", func(t *testing.T) {
+		err = llSig.VerifyPoP(pk, nil)
+		require.Equal(t, crypto.ErrNilSignature, err)
+	})
+	t.Run("invalid pop bytes should err", func(t *testing.T) {
+		err = llSig.VerifyPoP(pk, []byte("invalid pop"))
+		require.NotNil(t, err)
+	})
+	t.Run("pop of another key should err", func(t *testing.T) {
+		_, otherPk := kg.GeneratePair()
+		err = llSig.VerifyPoP(otherPk, pop)
+		require.Equal(t, crypto.ErrPoPNotValid, err)
+	})
+	t.Run("signature over the public key should not be a valid pop", func(t *testing.T) {
+		pkBytes, _ := pk.ToByteArray()
+		sig, errSign := llSig.SignShare(sk, pkBytes)
+		require.Nil(t, errSign)
+
+		err = llSig.VerifyPoP(pk, sig)
+		require.Equal(t, crypto.ErrPoPNotValid, err)
+	})
+	t.Run("pop should not be a valid signature over the public key", func(t *testing.T) {
+		pkBytes, _ := pk.ToByteArray()
+		err = llSig.VerifySigShare(pk, pkBytes, pop)
+		require.Equal(t, crypto.ErrSigNotValid, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		err = llSig.VerifyPoP(pk, pop)
+		require.Nil(t, err)
+	})
+}
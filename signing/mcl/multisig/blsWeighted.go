@@ -0,0 +1,310 @@
+package multisig
+
+import (
+	"math/bits"
+	"strconv"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+/*
+Stake weighted multi-signatures, where each signer contributes to the aggregation proportionally to an integer weight:
+
+	aggSig = sum(w_i*sig_i)
+	aggPk  = sum(w_i*pk_i)
+
+As sig_i = sk_i*H(m), the weighted aggregated signature verifies against the weighted aggregated public key. The
+verification binds the weights only up to a common factor: k*aggSig is valid for the weights k*w_i, so anyone holding
+a weighted aggregated signature can multiply the total weight returned by the verification. The total weight is only
+meaningful when the verifier takes the weights from a trusted stake table, not from the sender of the signature.
+
+With the rogue key protection of BlsMultiSigner, the weights multiply the rogue key coefficients, so each sum is still
+computed with a single multi-scalar multiplication, with the scalars t_i*w_i.
+*/
+
+var _ crypto.LowLevelWeightedSignerBLS = (*BlsMultiSigner)(nil)
+var _ crypto.LowLevelWeightedSignerBLS = (*BlsMultiSignerKOSK)(nil)
+
+// AggregateWeightedSignatures produces an aggregation of single BLS signatures over the same message, where each
+// signature is multiplied by the rogue key coefficient and by the weight of its signer
+func (bms *BlsMultiSigner) AggregateWeightedSignatures(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+	weights []uint64,
+) ([]byte, error) {
+	err := checkWeightedAggregationArgs(suite, signatures, pubKeysSigners, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	sigsPoints, coefficients, err := bms.signaturesCoefficients(suite, signatures, pubKeysSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregateWeightedSignatures(sigsPoints, weights, coefficients)
+}
+
+// VerifyWeightedAggregatedSig verifies a weighted aggregated signature over a given message and returns the total
+// weight of the signers
+func (bms *BlsMultiSigner) VerifyWeightedAggregatedSig(
+	suite crypto.Suite,
+	pubKeys []crypto.PublicKey,
+	weights []uint64,
+	aggSigBytes []byte,
+	msg []byte,
+) (uint64, error) {
+	err := checkWeightedVerificationArgs(suite, pubKeys, weights, aggSigBytes, msg)
+	if err != nil {
+		return 0, err
+	}
+	if check.IfNil(bms.Cache) {
+		// the cached validator sets are validated once, when computing their coefficients
+		err = checkPublicKeys(pubKeys, bms.NumWorkers)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	pubKeysPoints, err := pubKeysToPointsG2(pubKeys)
+	if err != nil {
+		return 0, err
+	}
+
+	coefficients, err := bms.rogueKeyCoefficients(suite, pubKeys)
+	if err != nil {
+		return 0, err
+	}
+
+	return verifyWeightedAggregatedSig(&bms.BlsSingleSigner, pubKeysPoints, weights, coefficients, aggSigBytes, msg)
+}
+
+// AggregateWeightedSignatures produces an aggregation of single BLS signatures over the same message, where each
+// signature is multiplied by the weight of its signer
+func (bms *BlsMultiSignerKOSK) AggregateWeightedSignatures(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+	weights []uint64,
+) ([]byte, error) {
+	err := checkWeightedAggregationArgs(suite, signatures, pubKeysSigners, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	sigsPoints := make([]bls.G1, len(signatures))
+	for i, sig := range signatures {
+		err = sigBytesIntoPoint(sig, &sigsPoints[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return aggregateWeightedSignatures(sigsPoints, weights, nil)
+}
+
+// VerifyWeightedAggregatedSig verifies a weighted aggregated signature over a given message and returns the total
+// weight of the signers
+func (bms *BlsMultiSignerKOSK) VerifyWeightedAggregatedSig(
+	suite crypto.Suite,
+	pubKeys []crypto.PublicKey,
+	weights []uint64,
+	aggSigBytes []byte,
+	msg []byte,
+) (uint64, error) {
+	err := checkWeightedVerificationArgs(suite, pubKeys, weights, aggSigBytes, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	pubKeysG2, err := pubKeysCryptoToValidG2(pubKeys)
+	if err != nil {
+		return 0, err
+	}
+
+	return verifyWeightedAggregatedSig(&bms.BlsSingleSigner, pubKeysG2, weights, nil, aggSigBytes, msg)
+}
+
+func checkWeightedAggregationArgs(
+	suite crypto.Suite,
+	signatures [][]byte,
+	pubKeysSigners []crypto.PublicKey,
+	weights []uint64,
+) error {
+	if check.IfNil(suite) {
+		return crypto.ErrNilSuite
+	}
+	if len(signatures) == 0 {
+		return crypto.ErrNilSignaturesList
+	}
+	if len(pubKeysSigners) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+	if len(pubKeysSigners) != len(signatures) || len(weights) != len(signatures) {
+		return crypto.ErrInvalidParam
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return crypto.ErrInvalidSuite
+	}
+
+	return nil
+}
+
+func checkWeightedVerificationArgs(
+	suite crypto.Suite,
+	pubKeys []crypto.PublicKey,
+	weights []uint64,
+	aggSigBytes []byte,
+	msg []byte,
+) error {
+	if check.IfNil(suite) {
+		return crypto.ErrNilSuite
+	}
+	if len(pubKeys) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+	if len(weights) != len(pubKeys) {
+		return crypto.ErrInvalidParam
+	}
+	if len(aggSigBytes) == 0 {
+		return crypto.ErrNilSignature
+	}
+	if len(msg) == 0 {
+		return crypto.ErrNilMessage
+	}
+	_, ok := suite.GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return crypto.ErrInvalidSuite
+	}
+
+	return nil
+}
+
+func aggregateWeightedSignatures(sigsPoints []bls.G1, weights []uint64, coefficients []bls.Fr) ([]byte, error) {
+	_, err := totalWeight(weights)
+	if err != nil {
+		return nil, err
+	}
+
+	scalars, err := weightedScalars(weights, coefficients)
+	if err != nil {
+		return nil, err
+	}
+
+	// sum(w_i*sig_i)
+	aggSig := &bls.G1{}
+	err = multiScalarMulG1(aggSig, sigsPoints, scalars, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggSig.Serialize(), nil
+}
+
+func verifyWeightedAggregatedSig(
+	signer *singlesig.BlsSingleSigner,
+	pubKeysPoints []bls.G2,
+	weights []uint64,
+	coefficients []bls.Fr,
+	aggSigBytes []byte,
+	msg []byte,
+) (uint64, error) {
+	total, err := totalWeight(weights)
+	if err != nil {
+		return 0, err
+	}
+
+	scalars, err := weightedScalars(weights, coefficients)
+	if err != nil {
+		return 0, err
+	}
+
+	// sum(w_i*pk_i)
+	aggPubKey := &bls.G2{}
+	err = multiScalarMulG2(aggPubKey, pubKeysPoints, scalars, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	aggSig := &bls.Sign{}
+	err = aggSig.Deserialize(aggSigBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	err = fastAggregateVerify(signer, aggSig, []bls.PublicKey{*bls.CastToPublicKey(aggPubKey)}, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// weightedScalars converts the weights to scalars, multiplied by the rogue key coefficients if provided
+func weightedScalars(weights []uint64, coefficients []bls.Fr) ([]bls.Fr, error) {
+	if coefficients != nil && len(coefficients) != len(weights) {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	scalars := make([]bls.Fr, len(weights))
+	for i, weight := range weights {
+		if weight == 0 {
+			return nil, crypto.ErrInvalidWeight
+		}
+
+		err := scalars[i].SetString(strconv.FormatUint(weight, 10), 10)
+		if err != nil {
+			return nil, crypto.ErrInvalidScalar
+		}
+
+		if coefficients != nil {
+			// t_i*w_i
+			bls.FrMul(&scalars[i], &scalars[i], &coefficients[i])
+		}
+	}
+
+	return scalars, nil
+}
+
+// pubKeysToPointsG2 returns the G2 points of the public keys, copied in one contiguous buffer
+func pubKeysToPointsG2(pubKeys []crypto.PublicKey) ([]bls.G2, error) {
+	pubKeysPoints := make([]bls.G2, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		if check.IfNil(pubKey) {
+			return nil, crypto.ErrNilPublicKey
+		}
+
+		mclPointG2, isPoint := pubKey.Point().(*mcl.PointG2)
+		if !isPoint || mclPointG2.G2 == nil {
+			return nil, crypto.ErrInvalidPublicKey
+		}
+
+		pubKeysPoints[i] = *mclPointG2.G2
+	}
+
+	return pubKeysPoints, nil
+}
+
+// totalWeight returns the sum of the weights, which must all be positive and must not overflow
+func totalWeight(weights []uint64) (uint64, error) {
+	total := uint64(0)
+	for _, weight := range weights {
+		if weight == 0 {
+			return 0, crypto.ErrInvalidWeight
+		}
+
+		var carry uint64
+		total, carry = bits.Add64(total, weight, 0)
+		if carry != 0 {
+			return 0, crypto.ErrInvalidWeight
+		}
+	}
+
+	return total, nil
+}
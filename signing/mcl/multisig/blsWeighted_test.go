@@ -0,0 +1,186 @@
+package multisig_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/mock"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/stretchr/testify/require"
+)
+
+type weightedLowLevelSigner interface {
+	crypto.LowLevelSignerBLS
+	crypto.LowLevelWeightedSignerBLS
+}
+
+func createWeightedLowLevelSigners() map[string]weightedLowLevelSigner {
+	withPairingLines := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	withPairingLines.PairingLines, _ = mcl.NewPairingLinesCache(10)
+
+	return map[string]weightedLowLevelSigner{
+		"with rogue key prevention": &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}},
+		"with KOSK":                 &multisig.BlsMultiSignerKOSK{},
+		"with pairing lines":        withPairingLines,
+	}
+}
+
+func createZeroPubKey() crypto.PublicKey {
+	zeroPoint := mcl.NewPointG2().Null()
+	zeroPointBytes, _ := zeroPoint.MarshalBinary()
+
+	return &mock.PublicKeyStub{
+		PointStub: func() crypto.Point {
+			return zeroPoint
+		},
+		ToByteArrayStub: func() ([]byte, error) {
+			return zeroPointBytes, nil
+		},
+	}
+}
+
+func createScalarFromUint(value uint64) crypto.Scalar {
+	scalar := mcl.NewScalar()
+	scalar.Scalar.SetInt64(int64(value))
+
+	return scalar
+}
+
+func TestBlsMultiSigner_AggregateWeightedSignatures(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	for name, llSig := range createWeightedLowLevelSigners() {
+		llSig := llSig
+		t.Run(name, func(t *testing.T) {
+			pubKeys, sigShares := createSigSharesBLS(5, msg, llSig)
+			suite := pubKeys[0].Suite()
+			weights := []uint64{1, 2, 3, 4, 5}
+
+			aggSig, err := llSig.AggregateWeightedSignatures(nil, sigShares, pubKeys, weights)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrNilSuite, err)
+
+			aggSig, err = llSig.AggregateWeightedSignatures(suite, nil, pubKeys, weights)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrNilSignaturesList, err)
+
+			aggSig, err = llSig.AggregateWeightedSignatures(suite, sigShares, nil, weights)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrNilPublicKeys, err)
+
+			aggSig, err = llSig.AggregateWeightedSignatures(suite, sigShares, pubKeys, weights[1:])
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrInvalidParam, err)
+
+			aggSig, err = llSig.AggregateWeightedSignatures(createMockSuite("invalid suite"), sigShares, pubKeys, weights)
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrInvalidSuite, err)
+
+			aggSig, err = llSig.AggregateWeightedSignatures(suite, sigShares, pubKeys, []uint64{1, 2, 0, 4, 5})
+			require.Nil(t, aggSig)
+			require.Equal(t, crypto.ErrInvalidWeight, err)
+
+			aggSig, err = llSig.AggregateWeightedSignatures(suite, sigShares, pubKeys, weights)
+			require.Nil(t, err)
+			require.NotNil(t, aggSig)
+		})
+	}
+}
+
+func TestBlsMultiSigner_VerifyWeightedAggregatedSig(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	for name, llSig := range createWeightedLowLevelSigners() {
+		llSig := llSig
+		t.Run(name, func(t *testing.T) {
+			pubKeys, sigShares := createSigSharesBLS(5, msg, llSig)
+			suite := pubKeys[0].Suite()
+			weights := []uint64{10, 20, 30, 40, math.MaxUint32}
+			expectedTotalWeight := uint64(100 + math.MaxUint32)
+
+			aggSig, err := llSig.AggregateWeightedSignatures(suite, sigShares, pubKeys, weights)
+			require.Nil(t, err)
+
+			t.Run("invalid arguments should err", func(t *testing.T) {
+				_, err = llSig.VerifyWeightedAggregatedSig(nil, pubKeys, weights, aggSig, msg)
+				require.Equal(t, crypto.ErrNilSuite, err)
+
+				_, err = llSig.VerifyWeightedAggregatedSig(suite, nil, weights, aggSig, msg)
+				require.Equal(t, crypto.ErrNilPublicKeys, err)
+
+				_, err = llSig.VerifyWeightedAggregatedSig(suite, pubKeys, weights[:4], aggSig, msg)
+				require.Equal(t, crypto.ErrInvalidParam, err)
+
+				_, err = llSig.VerifyWeightedAggregatedSig(suite, pubKeys, weights, nil, msg)
+				require.Equal(t, crypto.ErrNilSignature, err)
+
+				_, err = llSig.VerifyWeightedAggregatedSig(suite, pubKeys, weights, aggSig, nil)
+				require.Equal(t, crypto.ErrNilMessage, err)
+			})
+			t.Run("zero or overflowing weights should err", func(t *testing.T) {
+				_, err = llSig.VerifyWeightedAggregatedSig(suite, pubKeys, []uint64{10, 0, 30, 40, 50}, aggSig, msg)
+				require.Equal(t, crypto.ErrInvalidWeight, err)
+
+				_, err = llSig.VerifyWeightedAggregatedSig(suite, pubKeys, []uint64{10, 20, 30, 40, math.MaxUint64}, aggSig, msg)
+				require.Equal(t, crypto.ErrInvalidWeight, err)
+			})
+			t.Run("other weights should err", func(t *testing.T) {
+				totalWeight, errVerify := llSig.VerifyWeightedAggregatedSig(suite, pubKeys, []uint64{20, 10, 30, 40, math.MaxUint32}, aggSig, msg)
+				require.Equal(t, crypto.ErrAggSigNotValid, errVerify)
+				require.Zero(t, totalWeight)
+			})
+			t.Run("unweighted aggregated signature should err", func(t *testing.T) {
+				unweightedAggSig, _ := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+				_, err = llSig.VerifyWeightedAggregatedSig(suite, pubKeys, weights, unweightedAggSig, msg)
+				require.Equal(t, crypto.ErrAggSigNotValid, err)
+			})
+			t.Run("other message should err", func(t *testing.T) {
+				_, err = llSig.VerifyWeightedAggregatedSig(suite, pubKeys, weights, aggSig, []byte("other message"))
+				require.Equal(t, crypto.ErrAggSigNotValid, err)
+			})
+			t.Run("invalid public key should err", func(t *testing.T) {
+				invalidPubKeys := append([]crypto.PublicKey{}, pubKeys...)
+				invalidPubKeys[2] = createZeroPubKey()
+
+				totalWeight, errVerify := llSig.VerifyWeightedAggregatedSig(suite, invalidPubKeys, weights, aggSig, msg)
+				require.Equal(t, crypto.ErrInvalidPublicKey, errVerify)
+				require.Zero(t, totalWeight)
+			})
+			t.Run("scaled aggregated signature should be valid for the scaled weights", func(t *testing.T) {
+				// the weights are only bound up to a common factor, so the total weight is meaningful only if the
+				// weights come from a trusted stake table
+				scaledWeights := make([]uint64, len(weights))
+				for i := range weights {
+					scaledWeights[i] = 3 * weights[i]
+				}
+
+				aggSigPoint, _ := sigBytesToPointG1(aggSig)
+				scaledAggSigPoint, _ := aggSigPoint.Mul(createScalarFromUint(3))
+				scaledAggSig, _ := scaledAggSigPoint.MarshalBinary()
+
+				totalWeight, errVerify := llSig.VerifyWeightedAggregatedSig(suite, pubKeys, scaledWeights, scaledAggSig, msg)
+				require.Nil(t, errVerify)
+				require.Equal(t, 3*expectedTotalWeight, totalWeight)
+			})
+			t.Run("should work and return the total weight", func(t *testing.T) {
+				totalWeight, errVerify := llSig.VerifyWeightedAggregatedSig(suite, pubKeys, weights, aggSig, msg)
+				require.Nil(t, errVerify)
+				require.Equal(t, expectedTotalWeight, totalWeight)
+			})
+			t.Run("unit weights should match the unweighted aggregation", func(t *testing.T) {
+				unitWeights := []uint64{1, 1, 1, 1, 1}
+				weightedAggSig, _ := llSig.AggregateWeightedSignatures(suite, sigShares, pubKeys, unitWeights)
+				unweightedAggSig, _ := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+				require.Equal(t, unweightedAggSig, weightedAggSig)
+
+				totalWeight, errVerify := llSig.VerifyWeightedAggregatedSig(suite, pubKeys, unitWeights, weightedAggSig, msg)
+				require.Nil(t, errVerify)
+				require.Equal(t, uint64(len(pubKeys)), totalWeight)
+			})
+		})
+	}
+}
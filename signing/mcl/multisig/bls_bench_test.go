@@ -1,6 +1,7 @@
 package multisig_test
 
 import (
+	"runtime"
 	"testing"
 
 	"github.com/herumi/bls-go-binary/bls"
@@ -15,14 +16,30 @@ import (
 const blsHashSize = 16
 
 func Benchmark_PreparePublicKeys63(b *testing.B) {
-	benchmarkPreparePublicKeys(63, b)
+	benchmarkPreparePublicKeys(63, 1, b)
 }
 
 func Benchmark_PreparePublicKeys400(b *testing.B) {
-	benchmarkPreparePublicKeys(400, b)
+	benchmarkPreparePublicKeys(400, 1, b)
 }
 
-func benchmarkPreparePublicKeys(nPubKeys int, b *testing.B) {
+func Benchmark_PreparePublicKeys1600(b *testing.B) {
+	benchmarkPreparePublicKeys(1600, 1, b)
+}
+
+func Benchmark_PreparePublicKeysParallel63(b *testing.B) {
+	benchmarkPreparePublicKeys(63, runtime.NumCPU(), b)
+}
+
+func Benchmark_PreparePublicKeysParallel400(b *testing.B) {
+	benchmarkPreparePublicKeys(400, runtime.NumCPU(), b)
+}
+
+func Benchmark_PreparePublicKeysParallel1600(b *testing.B) {
+	benchmarkPreparePublicKeys(1600, runtime.NumCPU(), b)
+}
+
+func benchmarkPreparePublicKeys(nPubKeys int, numWorkers int, b *testing.B) {
 	hasher, err := blake2b.NewBlake2bWithSize(blsHashSize)
 	require.Nil(b, err)
 
@@ -30,7 +47,7 @@ func benchmarkPreparePublicKeys(nPubKeys int, b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, hasher, pubKeys[0].Suite())
+		prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, hasher, pubKeys[0].Suite(), numWorkers)
 		require.Nil(b, err)
 		require.NotNil(b, prepPubKeys)
 	}
@@ -82,10 +99,38 @@ func Benchmark_AggregatedSigKOSK400(b *testing.B) {
 	benchmarkAggregatedSig(400, llSig, b)
 }
 
+func Benchmark_AggregatedSig1600(b *testing.B) {
+	hasher, err := blake2b.NewBlake2bWithSize(blsHashSize)
+	require.Nil(b, err)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
+
+	benchmarkAggregatedSig(1600, llSig, b)
+}
+
+func Benchmark_AggregatedSigParallel63(b *testing.B) {
+	benchmarkAggregatedSig(63, createParallelMultiSigner(b), b)
+}
+
+func Benchmark_AggregatedSigParallel400(b *testing.B) {
+	benchmarkAggregatedSig(400, createParallelMultiSigner(b), b)
+}
+
+func Benchmark_AggregatedSigParallel1600(b *testing.B) {
+	benchmarkAggregatedSig(1600, createParallelMultiSigner(b), b)
+}
+
+func createParallelMultiSigner(b *testing.B) *multisig.BlsMultiSigner {
+	hasher, err := blake2b.NewBlake2bWithSize(blsHashSize)
+	require.Nil(b, err)
+
+	return &multisig.BlsMultiSigner{Hasher: hasher, NumWorkers: runtime.NumCPU()}
+}
+
 func benchmarkAggregatedSig(nPubKeys uint16, llSig crypto.LowLevelSignerBLS, b *testing.B) {
 	msg := []byte(testMessage)
 	pubKeys, sigShares := createSigSharesBLS(nPubKeys, msg, llSig)
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, err := llSig.AggregateSignatures(pubKeys[0].Suite(), sigShares, pubKeys)
@@ -121,6 +166,26 @@ func Benchmark_VerifyAggregatedSigKOSK400(b *testing.B) {
 	benchmarkVerifyAggregatedSig(400, llSig, b)
 }
 
+func Benchmark_VerifyAggregatedSig1600(b *testing.B) {
+	hasher, err := blake2b.NewBlake2bWithSize(blsHashSize)
+	require.Nil(b, err)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
+
+	benchmarkVerifyAggregatedSig(1600, llSig, b)
+}
+
+func Benchmark_VerifyAggregatedSigParallel63(b *testing.B) {
+	benchmarkVerifyAggregatedSig(63, createParallelMultiSigner(b), b)
+}
+
+func Benchmark_VerifyAggregatedSigParallel400(b *testing.B) {
+	benchmarkVerifyAggregatedSig(400, createParallelMultiSigner(b), b)
+}
+
+func Benchmark_VerifyAggregatedSigParallel1600(b *testing.B) {
+	benchmarkVerifyAggregatedSig(1600, createParallelMultiSigner(b), b)
+}
+
 func benchmarkVerifyAggregatedSig(nPubKeys uint16, llSig crypto.LowLevelSignerBLS, b *testing.B) {
 	msg := []byte(testMessage)
 
@@ -128,6 +193,7 @@ func benchmarkVerifyAggregatedSig(nPubKeys uint16, llSig crypto.LowLevelSignerBL
 	aggSigBytes, err := llSig.AggregateSignatures(pubKeys[0].Suite(), sigShares, pubKeys)
 	require.Nil(b, err)
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		err = llSig.VerifyAggregatedSig(pubKeys[0].Suite(), pubKeys, aggSigBytes, msg)
@@ -153,7 +219,7 @@ func benchmarkVerifyAggregatedSigWithoutPrepare(nPubKeys uint16, b *testing.B) {
 	aggSigBytes, err := llSig.AggregateSignatures(pubKeys[0].Suite(), sigShares, pubKeys)
 	require.Nil(b, err)
 
-	prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, hasher, pubKeys[0].Suite())
+	prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, hasher, pubKeys[0].Suite(), 1)
 	require.Nil(b, err)
 
 	b.ResetTimer()
@@ -162,7 +228,7 @@ func benchmarkVerifyAggregatedSigWithoutPrepare(nPubKeys uint16, b *testing.B) {
 		err = aggSig.Deserialize(aggSigBytes)
 		require.Nil(b, err)
 
-		res := aggSig.FastAggregateVerify(prepPubKeys, msg)
+		res := aggSig.FastAggregateVerify([]bls.PublicKey{*prepPubKeys}, msg)
 		require.True(b, res)
 	}
 }
@@ -230,3 +296,56 @@ func benchmarkHashPublicKeyPoints(nPubKeys int, b *testing.B) {
 		require.NotNil(b, hash)
 	}
 }
+
+func Benchmark_VerifyAggregatedSigWithCache400(b *testing.B) {
+	hasher, err := blake2b.NewBlake2bWithSize(blsHashSize)
+	require.Nil(b, err)
+	cache, err := multisig.NewCoefficientsCache(10)
+	require.Nil(b, err)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+	benchmarkVerifyAggregatedSig(400, llSig, b)
+}
+
+func Benchmark_VerifyAggregatedSigWithCache63(b *testing.B) {
+	benchmarkVerifyAggregatedSig(63, createMultiSignerWithCache(b), b)
+}
+
+func Benchmark_AggregatedSigWithCache63(b *testing.B) {
+	benchmarkAggregatedSig(63, createMultiSignerWithCache(b), b)
+}
+
+func createMultiSignerWithCache(b *testing.B) *multisig.BlsMultiSigner {
+	hasher, err := blake2b.NewBlake2bWithSize(blsHashSize)
+	require.Nil(b, err)
+	cache, err := multisig.NewCoefficientsCache(10)
+	require.Nil(b, err)
+
+	return &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+}
+
+func Benchmark_AggregateAndVerifySignaturesOneInvalid400(b *testing.B) {
+	benchmarkAggregateAndVerifySignatures(400, 1, b)
+}
+
+func Benchmark_AggregateAndVerifySignaturesTenInvalid400(b *testing.B) {
+	benchmarkAggregateAndVerifySignatures(400, 10, b)
+}
+
+func benchmarkAggregateAndVerifySignatures(nPubKeys uint16, nInvalid int, b *testing.B) {
+	msg := []byte(testMessage)
+	hasher, err := blake2b.NewBlake2bWithSize(blsHashSize)
+	require.Nil(b, err)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
+
+	pubKeys, sigShares := createSigSharesBLS(nPubKeys, msg, llSig)
+	for i := 0; i < nInvalid; i++ {
+		idx := i * int(nPubKeys) / nInvalid
+		sigShares[idx] = sigShares[idx+1]
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err = llSig.AggregateAndVerifySignatures(pubKeys[0].Suite(), sigShares, pubKeys, msg)
+		require.NotNil(b, err)
+	}
+}
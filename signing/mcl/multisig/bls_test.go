@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
 	"github.com/stretchr/testify/require"
 )
 
@@ -400,6 +401,64 @@ func TestBlsMultiSigner_VerifyAggregatedSigOK(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestBlsMultiSigners_WithDSTRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	dst := []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+	weights := []uint64{1, 2, 3, 4, 5}
+
+	signerWithDST, err := singlesig.NewBlsSignerWithDST(dst)
+	require.Nil(t, err)
+	signerWithDSTAndPairingLines, err := singlesig.NewBlsSignerWithDST(dst)
+	require.Nil(t, err)
+	signerWithDSTAndPairingLines.PairingLines, _ = mcl.NewPairingLinesCache(10)
+
+	type multiSignerWithFaultAttribution interface {
+		crypto.LowLevelSignerBLS
+		crypto.LowLevelWeightedSignerBLS
+		crypto.LowLevelFaultAttributionSignerBLS
+	}
+
+	for _, signer := range []*singlesig.BlsSingleSigner{signerWithDST, signerWithDSTAndPairingLines} {
+		llSigners := map[string]multiSignerWithFaultAttribution{
+			"BlsMultiSigner":     &multisig.BlsMultiSigner{BlsSingleSigner: *signer, Hasher: &mock.HasherSpongeMock{}},
+			"BlsMultiSignerKOSK": &multisig.BlsMultiSignerKOSK{BlsSingleSigner: *signer},
+		}
+		for name, llSig := range llSigners {
+			pubKeys, sigShares := createSigSharesBLS(5, msg, llSig)
+			suite := pubKeys[0].Suite()
+			for i := range sigShares {
+				require.Nil(t, llSig.VerifySigShare(pubKeys[i], msg, sigShares[i]), name)
+			}
+
+			aggSig, errAggregate := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+			require.Nil(t, errAggregate, name)
+			require.Nil(t, llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, msg), name)
+
+			aggSigWithFaultAttribution, errAggregate := llSig.AggregateAndVerifySignatures(suite, sigShares, pubKeys, msg)
+			require.Nil(t, errAggregate, name)
+			require.Equal(t, aggSig, aggSigWithFaultAttribution, name)
+
+			weightedAggSig, errAggregate := llSig.AggregateWeightedSignatures(suite, sigShares, pubKeys, weights)
+			require.Nil(t, errAggregate, name)
+			totalWeight, errVerify := llSig.VerifyWeightedAggregatedSig(suite, pubKeys, weights, weightedAggSig, msg)
+			require.Nil(t, errVerify, name)
+			require.Equal(t, uint64(15), totalWeight, name)
+		}
+	}
+
+	t.Run("aggregated signature should not be valid without the DST", func(t *testing.T) {
+		llSig := &multisig.BlsMultiSignerKOSK{BlsSingleSigner: *signerWithDST}
+		pubKeys, sigShares := createSigSharesBLS(5, msg, llSig)
+		aggSig, _ := llSig.AggregateSignatures(pubKeys[0].Suite(), sigShares, pubKeys)
+
+		llSigWithoutDST := &multisig.BlsMultiSignerKOSK{}
+		err := llSigWithoutDST.VerifyAggregatedSig(pubKeys[0].Suite(), pubKeys, aggSig, msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+}
+
 func TestBlsMultiSigner_VerifySigBytesNilSigShouldErr(t *testing.T) {
 	hasher := &mock.HasherSpongeMock{}
 	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
@@ -443,7 +502,7 @@ func Test_PreparePublicKeysNilHasherShouldErr(t *testing.T) {
 	hasher := &mock.HasherSpongeMock{}
 	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
 	pubKeys, _ := createSigSharesBLS(20, msg, llSig)
-	prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, nil, pubKeys[0].Suite())
+	prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, nil, pubKeys[0].Suite(), 1)
 	require.Equal(t, crypto.ErrNilHasher, err)
 	require.Nil(t, prepPubKeys)
 }
@@ -455,7 +514,7 @@ func Test_PreparePublicKeysNilSuiteShouldErr(t *testing.T) {
 	msg := []byte(testMessage)
 	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
 	pubKeys, _ := createSigSharesBLS(20, msg, llSig)
-	prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, hasher, nil)
+	prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, hasher, nil, 1)
 	require.Equal(t, crypto.ErrNilSuite, err)
 	require.Nil(t, prepPubKeys)
 }
@@ -467,7 +526,7 @@ func Test_PreparePublicKeysOK(t *testing.T) {
 	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
 	msg := []byte(testMessage)
 	pubKeys, _ := createSigSharesBLS(20, msg, llSig)
-	prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, hasher, pubKeys[0].Suite())
+	prepPubKeys, err := multisig.PreparePublicKeys(pubKeys, hasher, pubKeys[0].Suite(), 1)
 	require.Nil(t, err)
 	require.NotNil(t, prepPubKeys)
 }
@@ -583,3 +642,105 @@ func TestBlsMultiSigner_IsInterfaceNil(t *testing.T) {
 
 	require.False(t, check.IfNil(llSig))
 }
+
+func TestBlsMultiSigner_AggregatePublicKeys(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	hasher := &mock.HasherSpongeMock{}
+	cache, _ := multisig.NewCoefficientsCache(1)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
+	llSigWithCache := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+	pubKeys, sigShares := createSigSharesBLS(20, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	t.Run("nil suite should err", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(nil, pubKeys)
+		require.Equal(t, crypto.ErrNilSuite, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("invalid suite should err", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(createMockSuite("invalid suite"), pubKeys)
+		require.Equal(t, crypto.ErrInvalidSuite, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("empty pub keys should err", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(suite, nil)
+		require.Equal(t, crypto.ErrNilPublicKeys, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("nil pub key should err", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(suite, []crypto.PublicKey{pubKeys[0], nil})
+		require.Equal(t, crypto.ErrNilPublicKey, err)
+		require.Nil(t, aggPubKey)
+	})
+	t.Run("should work", func(t *testing.T) {
+		aggPubKey, err := llSig.AggregatePublicKeys(suite, pubKeys)
+		require.Nil(t, err)
+
+		aggPubKeyWithCache, err := llSigWithCache.AggregatePublicKeys(suite, pubKeys)
+		require.Nil(t, err)
+		require.Equal(t, aggPubKey.Point(), aggPubKeyWithCache.Point())
+
+		aggSig, err := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+		require.Nil(t, err)
+
+		err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, aggPubKey, aggSig, msg)
+		require.Nil(t, err)
+
+		err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, aggPubKey, aggSig, []byte("other message"))
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+
+		// the plain sum of the public keys is not the aggregated key of the modified BLS scheme
+		kosk := &multisig.BlsMultiSignerKOSK{}
+		plainAggPubKey, err := kosk.AggregatePublicKeys(suite, pubKeys)
+		require.Nil(t, err)
+		err = llSig.VerifyAggregatedSigWithAggregatedPubKey(suite, plainAggPubKey, aggSig, msg)
+		require.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+}
+
+func TestBlsMultiSigner_AggregateSignaturesWithCacheAllocationsShouldNotDependOnSigners(t *testing.T) {
+	msg := []byte(testMessage)
+	hasher := &mock.HasherSpongeMock{}
+	cache, _ := multisig.NewCoefficientsCache(2)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+	pubKeys, sigShares := createSigSharesBLS(20, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	// the coefficients of both validator sets are computed and cached by the first aggregations
+	_, err := llSig.AggregateSignatures(suite, sigShares[:2], pubKeys[:2])
+	require.Nil(t, err)
+	_, err = llSig.AggregateSignatures(suite, sigShares, pubKeys)
+	require.Nil(t, err)
+
+	allocsFewSigners := testing.AllocsPerRun(10, func() {
+		_, _ = llSig.AggregateSignatures(suite, sigShares[:2], pubKeys[:2])
+	})
+	allocsAllSigners := testing.AllocsPerRun(10, func() {
+		_, _ = llSig.AggregateSignatures(suite, sigShares, pubKeys)
+	})
+	require.Equal(t, allocsFewSigners, allocsAllSigners)
+}
+
+func TestBlsMultiSigner_VerifyAggregatedSigWithCacheAllocationsShouldNotDependOnSigners(t *testing.T) {
+	msg := []byte(testMessage)
+	hasher := &mock.HasherSpongeMock{}
+	cache, _ := multisig.NewCoefficientsCache(2)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+	pubKeys, sigShares := createSigSharesBLS(20, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	aggSigFewSigners, err := llSig.AggregateSignatures(suite, sigShares[:2], pubKeys[:2])
+	require.Nil(t, err)
+	aggSigAllSigners, err := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+	require.Nil(t, err)
+
+	allocsFewSigners := testing.AllocsPerRun(10, func() {
+		_ = llSig.VerifyAggregatedSig(suite, pubKeys[:2], aggSigFewSigners, msg)
+	})
+	allocsAllSigners := testing.AllocsPerRun(10, func() {
+		_ = llSig.VerifyAggregatedSig(suite, pubKeys, aggSigAllSigners, msg)
+	})
+	require.Equal(t, allocsFewSigners, allocsAllSigners)
+}
@@ -0,0 +1,132 @@
+package multisig
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+// hasherFingerprintInput is hashed with the hasher of a signer to tell apart the coefficients computed with
+// different hashers, when a cache is shared by several signers
+const hasherFingerprintInput = "coefficients cache hasher fingerprint"
+
+// validatorSetCoefficients holds, for an ordered set of public keys, the rogue key coefficients
+// t_i = H1(pk_i, {pk_1, ..., pk_n}) and the aggregated public key sum(t_i*pk_i)
+type validatorSetCoefficients struct {
+	coefficients     []bls.Fr
+	aggregatedPubKey bls.PublicKey
+}
+
+type coefficientsCacheEntry struct {
+	key          [sha256.Size]byte
+	coefficients *validatorSetCoefficients
+}
+
+// CoefficientsCache is a bounded, concurrency safe LRU cache that stores the rogue key coefficients and the scaled
+// public keys for validator sets. A validator set is identified by the hash of its ordered public keys and of the
+// fingerprint of the hasher the coefficients are computed with, so the cache can be shared by signers with
+// different hashers.
+// The cache is expected to be cleared on every epoch change, when the consensus groups change.
+type CoefficientsCache struct {
+	mut        sync.Mutex
+	maxEntries int
+	entries    map[[sha256.Size]byte]*list.Element
+	lru        *list.List
+}
+
+// NewCoefficientsCache creates a coefficients cache that holds at most maxEntries validator sets
+func NewCoefficientsCache(maxEntries int) (*CoefficientsCache, error) {
+	if maxEntries <= 0 {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	return &CoefficientsCache{
+		maxEntries: maxEntries,
+		entries:    make(map[[sha256.Size]byte]*list.Element),
+		lru:        list.New(),
+	}, nil
+}
+
+// Clear removes all the cached validator sets. It should be called on epoch change
+func (cc *CoefficientsCache) Clear() {
+	cc.mut.Lock()
+	defer cc.mut.Unlock()
+
+	cc.entries = make(map[[sha256.Size]byte]*list.Element)
+	cc.lru.Init()
+}
+
+// Len returns the number of cached validator sets
+func (cc *CoefficientsCache) Len() int {
+	cc.mut.Lock()
+	defer cc.mut.Unlock()
+
+	return cc.lru.Len()
+}
+
+func (cc *CoefficientsCache) get(hasher hashing.Hasher, concatPubKeys []byte) (*validatorSetCoefficients, bool) {
+	key := coefficientsCacheKey(hasher, concatPubKeys)
+
+	cc.mut.Lock()
+	defer cc.mut.Unlock()
+
+	element, found := cc.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	cc.lru.MoveToFront(element)
+
+	return element.Value.(*coefficientsCacheEntry).coefficients, true
+}
+
+func (cc *CoefficientsCache) put(hasher hashing.Hasher, concatPubKeys []byte, coefficients *validatorSetCoefficients) {
+	key := coefficientsCacheKey(hasher, concatPubKeys)
+
+	cc.mut.Lock()
+	defer cc.mut.Unlock()
+
+	element, found := cc.entries[key]
+	if found {
+		element.Value.(*coefficientsCacheEntry).coefficients = coefficients
+		cc.lru.MoveToFront(element)
+		return
+	}
+
+	cc.entries[key] = cc.lru.PushFront(&coefficientsCacheEntry{
+		key:          key,
+		coefficients: coefficients,
+	})
+
+	for cc.lru.Len() > cc.maxEntries {
+		oldest := cc.lru.Back()
+		cc.lru.Remove(oldest)
+		delete(cc.entries, oldest.Value.(*coefficientsCacheEntry).key)
+	}
+}
+
+// coefficientsCacheKey returns sha256(len(fingerprint) | fingerprint | concatPubKeys), where the fingerprint is the
+// hash of a fixed input with the given hasher
+func coefficientsCacheKey(hasher hashing.Hasher, concatPubKeys []byte) [sha256.Size]byte {
+	fingerprint := hasher.Compute(hasherFingerprintInput)
+
+	h := sha256.New()
+	_, _ = h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(fingerprint))))
+	_, _ = h.Write(fingerprint)
+	_, _ = h.Write(concatPubKeys)
+
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+
+	return key
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cc *CoefficientsCache) IsInterfaceNil() bool {
+	return cc == nil
+}
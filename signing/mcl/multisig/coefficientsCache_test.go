@@ -0,0 +1,174 @@
+package multisig_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/mock"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/stretchr/testify/require"
+)
+
+// truncatedSha256Hasher is a hasher with the output size required by BlsMultiSigner, different from the sponge mock
+type truncatedSha256Hasher struct {
+	mock.HasherMock
+}
+
+func (hasher *truncatedSha256Hasher) Compute(s string) []byte {
+	h := sha256.Sum256([]byte(s))
+	return h[:multisig.HasherOutputSize]
+}
+
+func (hasher *truncatedSha256Hasher) Size() int {
+	return multisig.HasherOutputSize
+}
+
+func TestNewCoefficientsCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := multisig.NewCoefficientsCache(0)
+	require.Equal(t, crypto.ErrInvalidParam, err)
+	require.True(t, check.IfNil(cache))
+
+	cache, err = multisig.NewCoefficientsCache(2)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(cache))
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestBlsMultiSigner_WithCacheShouldMatchWithoutCache(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	hasher := &mock.HasherSpongeMock{}
+	cache, _ := multisig.NewCoefficientsCache(2)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher}
+	llSigWithCache := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+	pubKeys, sigShares := createSigSharesBLS(20, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	aggSig, err := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+	require.Nil(t, err)
+
+	aggSigWithCache, err := llSigWithCache.AggregateSignatures(suite, sigShares, pubKeys)
+	require.Nil(t, err)
+	require.Equal(t, aggSig, aggSigWithCache)
+	require.Equal(t, 1, cache.Len())
+
+	// second aggregation uses the cached coefficients
+	aggSigWithCache, err = llSigWithCache.AggregateSignatures(suite, sigShares, pubKeys)
+	require.Nil(t, err)
+	require.Equal(t, aggSig, aggSigWithCache)
+	require.Equal(t, 1, cache.Len())
+
+	err = llSigWithCache.VerifyAggregatedSig(suite, pubKeys, aggSig, msg)
+	require.Nil(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	err = llSigWithCache.VerifyAggregatedSig(suite, pubKeys, aggSig, []byte("other message"))
+	require.Equal(t, crypto.ErrAggSigNotValid, err)
+
+	err = llSig.VerifyAggregatedSig(suite, pubKeys, aggSigWithCache, msg)
+	require.Nil(t, err)
+}
+
+func TestBlsMultiSigner_WithCacheDifferentSetsShouldNotCollide(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	hasher := &mock.HasherSpongeMock{}
+	cache, _ := multisig.NewCoefficientsCache(2)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+	pubKeys, sigShares := createSigSharesBLS(10, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	aggSigAll, err := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+	require.Nil(t, err)
+	aggSigSubset, err := llSig.AggregateSignatures(suite, sigShares[:5], pubKeys[:5])
+	require.Nil(t, err)
+	require.Equal(t, 2, cache.Len())
+
+	err = llSig.VerifyAggregatedSig(suite, pubKeys, aggSigSubset, msg)
+	require.Equal(t, crypto.ErrAggSigNotValid, err)
+	err = llSig.VerifyAggregatedSig(suite, pubKeys[:5], aggSigAll, msg)
+	require.Equal(t, crypto.ErrAggSigNotValid, err)
+
+	err = llSig.VerifyAggregatedSig(suite, pubKeys, aggSigAll, msg)
+	require.Nil(t, err)
+	err = llSig.VerifyAggregatedSig(suite, pubKeys[:5], aggSigSubset, msg)
+	require.Nil(t, err)
+}
+
+func TestBlsMultiSigner_WithCacheShouldEvictAndClear(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	hasher := &mock.HasherSpongeMock{}
+	cache, _ := multisig.NewCoefficientsCache(2)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+	pubKeys, sigShares := createSigSharesBLS(10, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	for i := 1; i <= 4; i++ {
+		aggSig, err := llSig.AggregateSignatures(suite, sigShares[:i], pubKeys[:i])
+		require.Nil(t, err)
+
+		err = llSig.VerifyAggregatedSig(suite, pubKeys[:i], aggSig, msg)
+		require.Nil(t, err)
+	}
+	require.Equal(t, 2, cache.Len())
+
+	cache.Clear()
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestBlsMultiSigner_WithCacheMismatchedSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	hasher := &mock.HasherSpongeMock{}
+	cache, _ := multisig.NewCoefficientsCache(2)
+	llSig := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache}
+	pubKeys, sigShares := createSigSharesBLS(5, msg, llSig)
+
+	aggSig, err := llSig.AggregateSignatures(pubKeys[0].Suite(), sigShares[:4], pubKeys)
+	require.Equal(t, crypto.ErrInvalidParam, err)
+	require.Nil(t, aggSig)
+}
+
+func TestBlsMultiSigner_WithCacheSharedByDifferentHashersShouldNotCollide(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	cache, _ := multisig.NewCoefficientsCache(2)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}, Cache: cache}
+	llSigOtherHasher := &multisig.BlsMultiSigner{Hasher: &truncatedSha256Hasher{}, Cache: cache}
+	pubKeys, sigShares := createSigSharesBLS(5, msg, llSig)
+	suite := pubKeys[0].Suite()
+
+	aggSig, err := llSig.AggregateSignatures(suite, sigShares, pubKeys)
+	require.Nil(t, err)
+	aggSigOtherHasher, err := llSigOtherHasher.AggregateSignatures(suite, sigShares, pubKeys)
+	require.Nil(t, err)
+	require.NotEqual(t, aggSig, aggSigOtherHasher)
+	require.Equal(t, 2, cache.Len())
+
+	require.Nil(t, llSig.VerifyAggregatedSig(suite, pubKeys, aggSig, msg))
+	require.Nil(t, llSigOtherHasher.VerifyAggregatedSig(suite, pubKeys, aggSigOtherHasher, msg))
+	require.Equal(t, crypto.ErrAggSigNotValid, llSigOtherHasher.VerifyAggregatedSig(suite, pubKeys, aggSig, msg))
+}
+
+func TestBlsMultiSigner_WithCacheNilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	cache, _ := multisig.NewCoefficientsCache(2)
+	pubKeys, sigShares := createSigSharesBLS(3, msg, &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}})
+	llSig := &multisig.BlsMultiSigner{Cache: cache}
+
+	aggSig, err := llSig.AggregateSignatures(pubKeys[0].Suite(), sigShares, pubKeys)
+	require.Nil(t, aggSig)
+	require.Equal(t, crypto.ErrNilHasher, err)
+}
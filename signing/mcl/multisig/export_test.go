@@ -5,18 +5,25 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
 )
 
 func ScalarMulSig(suite crypto.Suite, scalarBytes []byte, sigPoint *mcl.PointG1) (*mcl.PointG1, error) {
 	return scalarMulSig(suite, scalarBytes, sigPoint)
 }
 
-func PreparePublicKeys(pubKeys []crypto.PublicKey, hasher hashing.Hasher, suite crypto.Suite) ([]bls.PublicKey, error) {
-	return preparePublicKeys(pubKeys, hasher, suite)
+func PreparePublicKeys(pubKeys []crypto.PublicKey, hasher hashing.Hasher, suite crypto.Suite, numWorkers int) (*bls.PublicKey, error) {
+	return preparePublicKeys(pubKeys, hasher, suite, numWorkers)
 }
 
-func (bms *BlsMultiSigner) PrepareSignatures(suite crypto.Suite, signatures [][]byte, pubKeysSigners []crypto.PublicKey) ([]bls.Sign, error) {
-	return bms.prepareSignatures(suite, signatures, pubKeysSigners)
+func (bms *BlsMultiSigner) PrepareSignatures(suite crypto.Suite, signatures [][]byte, pubKeysSigners []crypto.PublicKey) (*bls.Sign, error) {
+	aggSig := &bls.G1{}
+	err := bms.prepareSignatures(aggSig, suite, signatures, pubKeysSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.CastToSign(aggSig), nil
 }
 
 func ScalarMulPk(suite crypto.Suite, scalarBytes []byte, pk crypto.Point) (crypto.Point, error) {
@@ -46,3 +53,11 @@ func PubKeyCryptoToBLS(pubKey crypto.PublicKey) (*bls.PublicKey, error) {
 func PubKeysCryptoToBLS(pubKeys []crypto.PublicKey) ([]bls.PublicKey, error) {
 	return pubKeysCryptoToBLS(pubKeys)
 }
+
+func Chunking(numItems int, numWorkers int) (int, int) {
+	return chunking(numItems, numWorkers)
+}
+
+func FastAggregateVerify(signer *singlesig.BlsSingleSigner, aggSig *bls.Sign, pubKeys []bls.PublicKey, msg []byte) error {
+	return fastAggregateVerify(signer, aggSig, pubKeys, msg)
+}
@@ -0,0 +1,135 @@
+package multisig
+
+import (
+	"sync"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+// minItemsPerWorker is the minimum number of shares or public keys processed by a worker, below it the work is
+// split between fewer workers, as the cost of the goroutines would exceed the gain
+const minItemsPerWorker = 16
+
+/*
+The parallel processing splits the shares and the public keys in contiguous chunks, one for each worker, and every
+worker processes its chunk in the same order as the sequential processing. The results are then combined in the
+order of the chunks, so:
+  - the decoded points and the coefficients are the same as the sequential ones
+  - the returned error, if any, is the error of the first invalid item, as in the sequential processing
+  - the multi-scalar multiplications are split in partial sums, added together afterwards. As the group operation is
+    associative and commutative, the sum is the same point, and its serialization gives the same bytes
+*/
+
+// chunking returns the size and the number of the chunks the items are split into for the given number of workers.
+// All the chunks hold at least one item
+func chunking(numItems int, numWorkers int) (int, int) {
+	maxChunks := numItems / minItemsPerWorker
+	if numWorkers > maxChunks {
+		numWorkers = maxChunks
+	}
+	if numWorkers <= 1 {
+		return numItems, 1
+	}
+
+	chunkSize := (numItems + numWorkers - 1) / numWorkers
+
+	return chunkSize, (numItems + chunkSize - 1) / chunkSize
+}
+
+// processInParallel calls process on contiguous chunks of [0, numItems), in parallel when more than one worker is
+// configured, and returns the error of the first chunk that failed
+func processInParallel(numItems int, numWorkers int, process func(chunk int, start int, end int) error) error {
+	chunkSize, chunks := chunking(numItems, numWorkers)
+	if chunks == 1 {
+		return process(0, 0, numItems)
+	}
+
+	errs := make([]error, chunks)
+	wg := sync.WaitGroup{}
+	wg.Add(chunks)
+	for i := 0; i < chunks; i++ {
+		go func(chunk int) {
+			defer wg.Done()
+
+			start := chunk * chunkSize
+			end := start + chunkSize
+			if end > numItems {
+				end = numItems
+			}
+
+			errs[chunk] = process(chunk, start, end)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkPublicKeys checks that the public keys are valid, in parallel when more than one worker is configured
+func checkPublicKeys(pubKeys []crypto.PublicKey, numWorkers int) error {
+	return processInParallel(len(pubKeys), numWorkers, func(_ int, start int, end int) error {
+		_, err := pubKeysCryptoToValidG2(pubKeys[start:end])
+		return err
+	})
+}
+
+// multiScalarMulG1 sets out = sum(scalars_i*points_i), with partial multi-scalar multiplications in parallel. The
+// points and the scalars are passed by value, in contiguous buffers, so the sequential multiplication does not allocate
+func multiScalarMulG1(out *bls.G1, points []bls.G1, scalars []bls.Fr, numWorkers int) error {
+	if len(points) == 0 || len(points) != len(scalars) {
+		return crypto.ErrInvalidParam
+	}
+
+	_, chunks := chunking(len(points), numWorkers)
+	if chunks == 1 {
+		bls.G1MulVec(out, points, scalars)
+		return nil
+	}
+
+	partialSums := make([]bls.G1, chunks)
+	_ = processInParallel(len(points), numWorkers, func(chunk int, start int, end int) error {
+		bls.G1MulVec(&partialSums[chunk], points[start:end], scalars[start:end])
+		return nil
+	})
+
+	*out = partialSums[0]
+	for i := 1; i < chunks; i++ {
+		bls.G1Add(out, out, &partialSums[i])
+	}
+
+	return nil
+}
+
+// multiScalarMulG2 sets out = sum(scalars_i*points_i), with partial multi-scalar multiplications in parallel. The
+// points and the scalars are passed by value, in contiguous buffers, so the sequential multiplication does not allocate
+func multiScalarMulG2(out *bls.G2, points []bls.G2, scalars []bls.Fr, numWorkers int) error {
+	if len(points) == 0 || len(points) != len(scalars) {
+		return crypto.ErrInvalidParam
+	}
+
+	_, chunks := chunking(len(points), numWorkers)
+	if chunks == 1 {
+		bls.G2MulVec(out, points, scalars)
+		return nil
+	}
+
+	partialSums := make([]bls.G2, chunks)
+	_ = processInParallel(len(points), numWorkers, func(chunk int, start int, end int) error {
+		bls.G2MulVec(&partialSums[chunk], points[start:end], scalars[start:end])
+		return nil
+	})
+
+	*out = partialSums[0]
+	for i := 1; i < chunks; i++ {
+		bls.G2Add(out, out, &partialSums[i])
+	}
+
+	return nil
+}
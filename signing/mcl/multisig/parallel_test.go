@@ -0,0 +1,132 @@
+package multisig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunking(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sequential processing should use one chunk", func(t *testing.T) {
+		for _, numWorkers := range []int{-1, 0, 1} {
+			chunkSize, chunks := multisig.Chunking(400, numWorkers)
+			require.Equal(t, 400, chunkSize)
+			require.Equal(t, 1, chunks)
+		}
+	})
+	t.Run("few items should use one chunk", func(t *testing.T) {
+		chunkSize, chunks := multisig.Chunking(20, 8)
+		require.Equal(t, 20, chunkSize)
+		require.Equal(t, 1, chunks)
+
+		chunkSize, chunks = multisig.Chunking(0, 8)
+		require.Equal(t, 0, chunkSize)
+		require.Equal(t, 1, chunks)
+	})
+	t.Run("items should be split between the workers", func(t *testing.T) {
+		chunkSize, chunks := multisig.Chunking(400, 4)
+		require.Equal(t, 100, chunkSize)
+		require.Equal(t, 4, chunks)
+
+		chunkSize, chunks = multisig.Chunking(63, 2)
+		require.Equal(t, 32, chunkSize)
+		require.Equal(t, 2, chunks)
+	})
+	t.Run("chunks should not be empty", func(t *testing.T) {
+		for numItems := 0; numItems < 2000; numItems += 7 {
+			for _, numWorkers := range []int{2, 3, 7, 64, 1000} {
+				chunkSize, chunks := multisig.Chunking(numItems, numWorkers)
+				require.LessOrEqual(t, chunks, numWorkers)
+				require.Less(t, (chunks-1)*chunkSize, numItems+1)
+				require.GreaterOrEqual(t, chunks*chunkSize, numItems)
+			}
+		}
+	})
+}
+
+func TestBlsMultiSigner_ParallelShouldGiveSameResults(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	hasher, _ := blake2b.NewBlake2bWithSize(blsHashSize)
+	sequentialSigner := &multisig.BlsMultiSigner{Hasher: hasher}
+	pubKeys, sigShares := createSigSharesBLS(400, msg, sequentialSigner)
+	suite := pubKeys[0].Suite()
+
+	for _, numSigners := range []int{20, 63, 400} {
+		expectedAggSig, err := sequentialSigner.AggregateSignatures(suite, sigShares[:numSigners], pubKeys[:numSigners])
+		require.Nil(t, err)
+		expectedAggPubKey, err := sequentialSigner.AggregatePublicKeys(suite, pubKeys[:numSigners])
+		require.Nil(t, err)
+		expectedAggPubKeyBytes, _ := expectedAggPubKey.ToByteArray()
+
+		for _, numWorkers := range []int{2, 7} {
+			cache, _ := multisig.NewCoefficientsCache(2)
+			for _, cache := range []*multisig.CoefficientsCache{nil, cache} {
+				name := fmt.Sprintf("%d signers, %d workers, with cache %v", numSigners, numWorkers, cache != nil)
+				parallelSigner := &multisig.BlsMultiSigner{Hasher: hasher, Cache: cache, NumWorkers: numWorkers}
+
+				aggSig, err := parallelSigner.AggregateSignatures(suite, sigShares[:numSigners], pubKeys[:numSigners])
+				require.Nil(t, err, name)
+				require.Equal(t, expectedAggSig, aggSig, name)
+
+				aggPubKey, err := parallelSigner.AggregatePublicKeys(suite, pubKeys[:numSigners])
+				require.Nil(t, err, name)
+				aggPubKeyBytes, _ := aggPubKey.ToByteArray()
+				require.Equal(t, expectedAggPubKeyBytes, aggPubKeyBytes, name)
+
+				err = parallelSigner.VerifyAggregatedSig(suite, pubKeys[:numSigners], aggSig, msg)
+				require.Nil(t, err, name)
+
+				err = parallelSigner.VerifyAggregatedSig(suite, pubKeys[:numSigners], sigShares[0], msg)
+				require.Equal(t, crypto.ErrAggSigNotValid, err, name)
+			}
+		}
+	}
+}
+
+func TestBlsMultiSigner_ParallelShouldReturnTheErrorOfTheFirstInvalidItem(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte(testMessage)
+	hasher, _ := blake2b.NewBlake2bWithSize(blsHashSize)
+	sequentialSigner := &multisig.BlsMultiSigner{Hasher: hasher}
+	parallelSigner := &multisig.BlsMultiSigner{Hasher: hasher, NumWorkers: 8}
+	pubKeys, sigShares := createSigSharesBLS(400, msg, sequentialSigner)
+	suite := pubKeys[0].Suite()
+
+	t.Run("invalid signature shares", func(t *testing.T) {
+		invalidSigShares := make([][]byte, len(sigShares))
+		copy(invalidSigShares, sigShares)
+		invalidSigShares[150] = (&bls.Sign{}).Serialize()
+		invalidSigShares[350] = []byte("invalid signature")
+
+		expectedAggSig, expectedErr := sequentialSigner.AggregateSignatures(suite, invalidSigShares, pubKeys)
+		require.Equal(t, crypto.ErrBLSInvalidSignature, expectedErr)
+
+		aggSig, err := parallelSigner.AggregateSignatures(suite, invalidSigShares, pubKeys)
+		require.Equal(t, expectedAggSig, aggSig)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("invalid public keys", func(t *testing.T) {
+		invalidPubKeys := make([]crypto.PublicKey, len(pubKeys))
+		copy(invalidPubKeys, pubKeys)
+		invalidPubKeys[250] = nil
+
+		_, expectedErr := sequentialSigner.AggregateSignatures(suite, sigShares, invalidPubKeys)
+		require.Equal(t, crypto.ErrNilPublicKey, expectedErr)
+
+		_, err := parallelSigner.AggregateSignatures(suite, sigShares, invalidPubKeys)
+		require.Equal(t, expectedErr, err)
+
+		err = parallelSigner.VerifyAggregatedSig(suite, invalidPubKeys, sigShares[0], msg)
+		require.Equal(t, crypto.ErrNilPublicKey, err)
+	})
+}
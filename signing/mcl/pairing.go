@@ -0,0 +1,119 @@
+package mcl
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+/*
+The pairing e: G1 x G2 -> GT is computed in two steps: the Miller loop, which gives an element of the extension field
+Fp12, followed by the final exponentiation, which maps it into the subgroup GT. The Miller loops of several pairings can
+be multiplied together before a single final exponentiation, so a product of pairings such as the ones checked by the
+BLS verification costs much less than the same number of full pairings:
+
+	prod(e(P_i, Q_i)) = FinalExp(prod(MillerLoop(P_i, Q_i)))
+
+The group operation of GT is the multiplication of Fp12, so the elements returned here are combined with bls.GTMul and
+bls.GTPow, not with the Add and Sub methods of PointGT, which apply the addition of Fp12.
+*/
+
+// Pairing computes the pairing e(p1, p2)
+func (s *SuiteBLS12) Pairing(p1 *PointG1, p2 *PointG2) (*PointGT, error) {
+	if p1 == nil || p1.G1 == nil || p2 == nil || p2.G2 == nil {
+		return nil, crypto.ErrNilParam
+	}
+
+	result := NewPointGT()
+	bls.Pairing(result.GT, p1.G1, p2.G2)
+
+	return result, nil
+}
+
+// MillerLoop computes the product of the Miller loops of the pairs (points1[i], points2[i]). The result is not an
+// element of GT until FinalExp is applied on it
+func (s *SuiteBLS12) MillerLoop(points1 []*PointG1, points2 []*PointG2) (*PointGT, error) {
+	if len(points1) == 0 || len(points1) != len(points2) {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	pointsG1, pointsG2, err := pairsToBLS(points1, points2)
+	if err != nil {
+		return nil, err
+	}
+
+	result := NewPointGT()
+	bls.MillerLoopVec(result.GT, pointsG1, pointsG2)
+
+	return result, nil
+}
+
+// FinalExp applies the final exponentiation on the output of MillerLoop, which gives the product of the pairings
+func (s *SuiteBLS12) FinalExp(millerLoop *PointGT) (*PointGT, error) {
+	if millerLoop == nil || millerLoop.GT == nil {
+		return nil, crypto.ErrNilParam
+	}
+
+	result := NewPointGT()
+	bls.FinalExp(result.GT, millerLoop.GT)
+
+	return result, nil
+}
+
+// PairingProduct computes prod(e(points1[i], points2[i])) with a single final exponentiation
+func (s *SuiteBLS12) PairingProduct(points1 []*PointG1, points2 []*PointG2) (*PointGT, error) {
+	millerLoop, err := s.MillerLoop(points1, points2)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FinalExp(millerLoop)
+}
+
+// PairingProductIsOne checks that prod(e(points1[i], points2[i])) is the identity of GT. An equality of pairing
+// products is checked by moving all the pairings on one side, negating one of the points of each moved pairing:
+// e(P1, Q1) == e(P2, Q2) is checked as e(P1, Q1) * e(-P2, Q2) == 1
+func (s *SuiteBLS12) PairingProductIsOne(points1 []*PointG1, points2 []*PointG2) (bool, error) {
+	if len(points1) == 0 || len(points1) != len(points2) {
+		return false, crypto.ErrInvalidParam
+	}
+
+	pointsG1, pointsG2, err := pairsToBLS(points1, points2)
+	if err != nil {
+		return false, err
+	}
+
+	return IsPairingProductOne(pointsG1, pointsG2), nil
+}
+
+// IsPairingProductOne checks that prod(e(pointsG1[i], pointsG2[i])) is the identity of GT, with a single final
+// exponentiation. It is the pairing check of all the BLS verifications, which hold the points of the pairings in
+// contiguous buffers
+func IsPairingProductOne(pointsG1 []bls.G1, pointsG2 []bls.G2) bool {
+	if len(pointsG1) != len(pointsG2) {
+		return false
+	}
+
+	millerLoop := &bls.GT{}
+	bls.MillerLoopVec(millerLoop, pointsG1, pointsG2)
+
+	result := &bls.GT{}
+	bls.FinalExp(result, millerLoop)
+
+	return result.IsOne()
+}
+
+// pairsToBLS copies the points of the pairs in contiguous buffers
+func pairsToBLS(points1 []*PointG1, points2 []*PointG2) ([]bls.G1, []bls.G2, error) {
+	pointsG1 := make([]bls.G1, len(points1))
+	pointsG2 := make([]bls.G2, len(points2))
+	for i := range points1 {
+		if points1[i] == nil || points1[i].G1 == nil || points2[i] == nil || points2[i].G2 == nil {
+			return nil, nil, crypto.ErrNilParam
+		}
+
+		pointsG1[i] = *points1[i].G1
+		pointsG2[i] = *points2[i].G2
+	}
+
+	return pointsG1, pointsG2, nil
+}
@@ -0,0 +1,209 @@
+package mcl
+
+import (
+	"sync"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+/*
+The Miller loop of a pairing e(P, Q) goes through the bits of the curve parameter and, at each step, evaluates at P a
+line going through multiples of Q. The coefficients of these lines only depend on Q, so for a G2 point used in many
+pairings, such as the generator or the public key of a validator during an epoch, they can be computed once, and the
+following Miller loops only evaluate the stored lines at P.
+
+A BLS verification checks e(sig, g2) == e(H(m), pk) as e(sig, -g2) * e(H(m), pk) == 1. The lines of -g2 are always
+precomputed, and the lines of pk are taken from a PairingLinesCache when the public key was registered in it.
+
+The multisig verifications check the aggregated signature against the aggregated public key of the signers, which
+changes with every subset of signers and, for the rogue key resistant scheme, with the coefficients of that subset.
+The validators' own lines are of no use there: for a subset whose aggregated public key was not registered, as with
+RegisterAggregatedPubKey of the multisig signers, only the lines of the generator are reused.
+*/
+
+var (
+	negatedGeneratorLines     *PairingLinesG2
+	negatedGeneratorLinesOnce sync.Once
+)
+
+// PairingLinesG2 holds the precomputed Miller loop line coefficients of a G2 point
+type PairingLinesG2 struct {
+	lines []uint64
+}
+
+// NewPairingLinesG2 precomputes the Miller loop line coefficients of the given G2 point
+func NewPairingLinesG2(point *PointG2) (*PairingLinesG2, error) {
+	if point == nil || point.G2 == nil {
+		return nil, crypto.ErrNilParam
+	}
+	if point.G2.IsZero() || !point.G2.IsValid() || !point.G2.IsValidOrder() {
+		return nil, crypto.ErrInvalidPoint
+	}
+
+	lines := make([]uint64, bls.GetUint64NumToPrecompute())
+	bls.PrecomputeG2(lines, point.G2)
+
+	return &PairingLinesG2{lines: lines}, nil
+}
+
+// negatedGeneratorLinesG2 returns the precomputed lines of the negated generator of G2, computed on first use
+func negatedGeneratorLinesG2() *PairingLinesG2 {
+	negatedGeneratorLinesOnce.Do(func() {
+		negatedGenerator := &PointG2{G2: &bls.G2{}}
+		bls.G2Neg(negatedGenerator.G2, NewPointG2().G2)
+
+		var err error
+		negatedGeneratorLines, err = NewPairingLinesG2(negatedGenerator)
+		if err != nil {
+			panic(err.Error())
+		}
+	})
+
+	return negatedGeneratorLines
+}
+
+type pairingLinesCacheEntry struct {
+	lines *PairingLinesG2
+	epoch uint32
+}
+
+// PairingLinesCache is a bounded, concurrency safe cache of the precomputed pairing lines of registered G2 public
+// keys, identified by their serialization. Each entry is tagged with the last epoch in which its public key was
+// registered or used for a verification, and the epoch change evicts the entries that were neither registered nor
+// used in the current or the previous epoch, so the public keys of the validators that left are removed
+type PairingLinesCache struct {
+	mut        sync.Mutex
+	maxEntries int
+	epoch      uint32
+	entries    map[string]*pairingLinesCacheEntry
+}
+
+// NewPairingLinesCache creates a pairing lines cache that holds at most maxEntries public keys
+func NewPairingLinesCache(maxEntries int) (*PairingLinesCache, error) {
+	if maxEntries <= 0 {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	return &PairingLinesCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*pairingLinesCacheEntry),
+	}, nil
+}
+
+// Register precomputes and caches the pairing lines of the public key, for the current epoch. If the cache is full,
+// the entries that were not registered nor used in the current epoch are evicted to make room
+func (plc *PairingLinesCache) Register(pubKey *PointG2) error {
+	if pubKey == nil || pubKey.G2 == nil {
+		return crypto.ErrNilParam
+	}
+
+	key := string(pubKey.G2.Serialize())
+	_, found := plc.get(key)
+	if found {
+		return nil
+	}
+
+	// the lines are computed without holding the lock, so the verifications are not blocked meanwhile
+	lines, err := NewPairingLinesG2(pubKey)
+	if err != nil {
+		return err
+	}
+
+	plc.mut.Lock()
+	defer plc.mut.Unlock()
+
+	entry, found := plc.entries[key]
+	if found {
+		entry.epoch = plc.epoch
+		return nil
+	}
+
+	if len(plc.entries) >= plc.maxEntries {
+		plc.evictOlderThan(plc.epoch)
+	}
+	if len(plc.entries) >= plc.maxEntries {
+		return crypto.ErrPairingLinesCacheFull
+	}
+
+	plc.entries[key] = &pairingLinesCacheEntry{
+		lines: lines,
+		epoch: plc.epoch,
+	}
+
+	return nil
+}
+
+// SetEpoch sets the current epoch and evicts the entries that were neither registered nor used in the current or the
+// previous epoch
+func (plc *PairingLinesCache) SetEpoch(epoch uint32) {
+	plc.mut.Lock()
+	defer plc.mut.Unlock()
+
+	plc.epoch = epoch
+	if epoch > 0 {
+		plc.evictOlderThan(epoch - 1)
+	}
+}
+
+// Len returns the number of cached public keys
+func (plc *PairingLinesCache) Len() int {
+	plc.mut.Lock()
+	defer plc.mut.Unlock()
+
+	return len(plc.entries)
+}
+
+// VerifyPairing checks e(sig, g2) == e(hashPoint, pubKey), with the precomputed lines of the generator and, if the
+// public key is registered in the cache, with the precomputed lines of the public key
+func (plc *PairingLinesCache) VerifyPairing(sig *PointG1, hashPoint *PointG1, pubKey *PointG2) (bool, error) {
+	if sig == nil || sig.G1 == nil || hashPoint == nil || hashPoint.G1 == nil || pubKey == nil || pubKey.G2 == nil {
+		return false, crypto.ErrNilParam
+	}
+
+	// the two Miller loops are computed separately, as the binding of PrecomputedMillerLoop2 passes the first pair twice
+	millerLoop := &bls.GT{}
+	bls.PrecomputedMillerLoop(millerLoop, sig.G1, negatedGeneratorLinesG2().lines)
+
+	pubKeyMillerLoop := &bls.GT{}
+	pubKeyLines, found := plc.get(string(pubKey.G2.Serialize()))
+	if found {
+		bls.PrecomputedMillerLoop(pubKeyMillerLoop, hashPoint.G1, pubKeyLines.lines)
+	} else {
+		bls.MillerLoop(pubKeyMillerLoop, hashPoint.G1, pubKey.G2)
+	}
+	bls.GTMul(millerLoop, millerLoop, pubKeyMillerLoop)
+
+	result := &bls.GT{}
+	bls.FinalExp(result, millerLoop)
+
+	return result.IsOne(), nil
+}
+
+// get returns the lines of the public key with the given serialization and tags them as used in the current epoch
+func (plc *PairingLinesCache) get(key string) (*PairingLinesG2, bool) {
+	plc.mut.Lock()
+	defer plc.mut.Unlock()
+
+	entry, found := plc.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	entry.epoch = plc.epoch
+
+	return entry.lines, true
+}
+
+func (plc *PairingLinesCache) evictOlderThan(epoch uint32) {
+	for key, entry := range plc.entries {
+		if entry.epoch < epoch {
+			delete(plc.entries, key)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (plc *PairingLinesCache) IsInterfaceNil() bool {
+	return plc == nil
+}
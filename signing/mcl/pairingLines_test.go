@@ -0,0 +1,236 @@
+package mcl
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPairingTestData() (*PointG1, *PointG1, *PointG2) {
+	suite := NewSuiteBLS12()
+	privKey, pubKey := suite.CreateKeyPair()
+
+	hashPoint := &PointG1{G1: &bls.G1{}}
+	_ = hashPoint.G1.HashAndMapTo([]byte("message"))
+
+	sig := &PointG1{G1: &bls.G1{}}
+	bls.G1Mul(sig.G1, hashPoint.G1, privKey.(*Scalar).Scalar)
+
+	return sig, hashPoint, pubKey.(*PointG2)
+}
+
+func createRandomPointG2() *PointG2 {
+	point, _ := NewPointG2().Pick()
+
+	return point.(*PointG2)
+}
+
+func TestNewPairingLinesG2(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil point should err", func(t *testing.T) {
+		lines, err := NewPairingLinesG2(nil)
+		assert.Nil(t, lines)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		lines, err = NewPairingLinesG2(&PointG2{})
+		assert.Nil(t, lines)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("identity should err", func(t *testing.T) {
+		lines, err := NewPairingLinesG2(NewPointG2().Null().(*PointG2))
+		assert.Nil(t, lines)
+		assert.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		lines, err := NewPairingLinesG2(createRandomPointG2())
+		require.Nil(t, err)
+		require.Equal(t, bls.GetUint64NumToPrecompute(), len(lines.lines))
+	})
+}
+
+func TestNewPairingLinesCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := NewPairingLinesCache(0)
+	assert.Nil(t, cache)
+	assert.Equal(t, crypto.ErrInvalidParam, err)
+
+	cache, err = NewPairingLinesCache(10)
+	assert.Nil(t, err)
+	assert.False(t, cache.IsInterfaceNil())
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestPairingLinesCache_Register(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid public keys should err", func(t *testing.T) {
+		cache, _ := NewPairingLinesCache(10)
+
+		assert.Equal(t, crypto.ErrNilParam, cache.Register(nil))
+		assert.Equal(t, crypto.ErrNilParam, cache.Register(&PointG2{}))
+		assert.Equal(t, crypto.ErrInvalidPoint, cache.Register(NewPointG2().Null().(*PointG2)))
+		assert.Equal(t, 0, cache.Len())
+	})
+	t.Run("registering twice should keep one entry", func(t *testing.T) {
+		cache, _ := NewPairingLinesCache(10)
+		pubKey := createRandomPointG2()
+
+		require.Nil(t, cache.Register(pubKey))
+		require.Nil(t, cache.Register(pubKey.Clone().(*PointG2)))
+		require.Equal(t, 1, cache.Len())
+	})
+	t.Run("full cache should evict the entries of the previous epochs", func(t *testing.T) {
+		cache, _ := NewPairingLinesCache(2)
+		oldPubKey := createRandomPointG2()
+		usedPubKey := createRandomPointG2()
+		require.Nil(t, cache.Register(oldPubKey))
+		require.Nil(t, cache.Register(usedPubKey))
+
+		cache.SetEpoch(1)
+		require.Equal(t, 2, cache.Len())
+		_, found := cache.get(string(usedPubKey.G2.Serialize()))
+		require.True(t, found)
+
+		newPubKey := createRandomPointG2()
+		require.Nil(t, cache.Register(newPubKey))
+		require.Equal(t, 2, cache.Len())
+
+		_, found = cache.get(string(oldPubKey.G2.Serialize()))
+		require.False(t, found)
+		_, found = cache.get(string(newPubKey.G2.Serialize()))
+		require.True(t, found)
+
+		err := cache.Register(createRandomPointG2())
+		require.Equal(t, crypto.ErrPairingLinesCacheFull, err)
+		require.Equal(t, 2, cache.Len())
+	})
+}
+
+func TestPairingLinesCache_SetEpoch(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := NewPairingLinesCache(10)
+	pubKeyEpoch0 := createRandomPointG2()
+	pubKeyEpoch1 := createRandomPointG2()
+	usedPubKey := createRandomPointG2()
+	require.Nil(t, cache.Register(pubKeyEpoch0))
+	require.Nil(t, cache.Register(usedPubKey))
+
+	cache.SetEpoch(1)
+	require.Nil(t, cache.Register(pubKeyEpoch1))
+	require.Equal(t, 3, cache.Len())
+	_, found := cache.get(string(usedPubKey.G2.Serialize()))
+	require.True(t, found)
+
+	cache.SetEpoch(2)
+	require.Equal(t, 2, cache.Len())
+	_, found = cache.get(string(pubKeyEpoch0.G2.Serialize()))
+	require.False(t, found)
+	_, found = cache.get(string(usedPubKey.G2.Serialize()))
+	require.True(t, found)
+
+	cache.SetEpoch(3)
+	require.Equal(t, 1, cache.Len())
+	_, found = cache.get(string(usedPubKey.G2.Serialize()))
+	require.True(t, found)
+}
+
+func TestPairingLinesCache_VerifyPairing(t *testing.T) {
+	t.Parallel()
+
+	sig, hashPoint, pubKey := createPairingTestData()
+
+	t.Run("nil points should err", func(t *testing.T) {
+		cache, _ := NewPairingLinesCache(10)
+
+		isValid, err := cache.VerifyPairing(nil, hashPoint, pubKey)
+		assert.False(t, isValid)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		isValid, err = cache.VerifyPairing(sig, &PointG1{}, pubKey)
+		assert.False(t, isValid)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		isValid, err = cache.VerifyPairing(sig, hashPoint, nil)
+		assert.False(t, isValid)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("should work with and without the lines of the public key", func(t *testing.T) {
+		cache, _ := NewPairingLinesCache(10)
+		otherPubKey := createRandomPointG2()
+
+		for _, registered := range []bool{false, true} {
+			if registered {
+				require.Nil(t, cache.Register(pubKey))
+				require.Nil(t, cache.Register(otherPubKey))
+			}
+
+			isValid, err := cache.VerifyPairing(sig, hashPoint, pubKey)
+			require.Nil(t, err)
+			require.True(t, isValid)
+
+			isValid, err = cache.VerifyPairing(sig, hashPoint, otherPubKey)
+			require.Nil(t, err)
+			require.False(t, isValid)
+
+			isValid, err = cache.VerifyPairing(hashPoint, sig, pubKey)
+			require.Nil(t, err)
+			require.False(t, isValid)
+		}
+	})
+	t.Run("concurrent use should work", func(t *testing.T) {
+		cache, _ := NewPairingLinesCache(3)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				switch i % 3 {
+				case 0:
+					_ = cache.Register(pubKey)
+				case 1:
+					cache.SetEpoch(uint32(i))
+				default:
+					isValid, err := cache.VerifyPairing(sig, hashPoint, pubKey)
+					assert.Nil(t, err)
+					assert.True(t, isValid)
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func BenchmarkPairingLinesCache_VerifyPairing(b *testing.B) {
+	sig, hashPoint, pubKey := createPairingTestData()
+	cache, _ := NewPairingLinesCache(1)
+
+	b.Run("not registered", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = cache.VerifyPairing(sig, hashPoint, pubKey)
+		}
+	})
+
+	_ = cache.Register(pubKey)
+	b.Run("registered", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = cache.VerifyPairing(sig, hashPoint, pubKey)
+		}
+	})
+	b.Run("without precomputation", func(b *testing.B) {
+		suite := NewSuiteBLS12()
+		negatedGenerator := &PointG2{G2: &bls.G2{}}
+		bls.G2Neg(negatedGenerator.G2, NewPointG2().G2)
+		for i := 0; i < b.N; i++ {
+			_, _ = suite.PairingProductIsOne([]*PointG1{sig, hashPoint}, []*PointG2{negatedGenerator, pubKey})
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package mcl
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuiteBLS12_Pairing(t *testing.T) {
+	t.Parallel()
+
+	suite := NewSuiteBLS12()
+
+	t.Run("nil points should err", func(t *testing.T) {
+		result, err := suite.Pairing(nil, NewPointG2())
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+
+		result, err = suite.Pairing(NewPointG1(), &PointG2{})
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("pairing should be bilinear", func(t *testing.T) {
+		a, b := NewScalar(), NewScalar()
+		pointG1, _ := NewPointG1().Mul(a)
+		pointG2, _ := NewPointG2().Mul(b)
+
+		result, err := suite.Pairing(pointG1.(*PointG1), pointG2.(*PointG2))
+		require.Nil(t, err)
+
+		ab, _ := a.Mul(b)
+		expected := suite.GT.CreatePointForScalar(ab)
+		assert.True(t, expected.(*PointGT).GT.IsEqual(result.GT))
+	})
+	t.Run("pairing with the identity should be one", func(t *testing.T) {
+		result, err := suite.Pairing(NewPointG1().Null().(*PointG1), NewPointG2())
+		require.Nil(t, err)
+		assert.True(t, result.GT.IsOne())
+	})
+}
+
+func TestSuiteBLS12_MillerLoopFinalExp(t *testing.T) {
+	t.Parallel()
+
+	suite := NewSuiteBLS12()
+
+	t.Run("invalid points lists should err", func(t *testing.T) {
+		result, err := suite.MillerLoop(nil, nil)
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+
+		result, err = suite.MillerLoop([]*PointG1{NewPointG1()}, []*PointG2{NewPointG2(), NewPointG2()})
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+
+		result, err = suite.MillerLoop([]*PointG1{NewPointG1(), nil}, []*PointG2{NewPointG2(), NewPointG2()})
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("nil miller loop should err", func(t *testing.T) {
+		result, err := suite.FinalExp(nil)
+		assert.Nil(t, result)
+		assert.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("final exponentiation of the miller loop should be the pairing", func(t *testing.T) {
+		pointG1, _ := NewPointG1().Pick()
+		pointG2, _ := NewPointG2().Pick()
+
+		millerLoop, err := suite.MillerLoop([]*PointG1{pointG1.(*PointG1)}, []*PointG2{pointG2.(*PointG2)})
+		require.Nil(t, err)
+
+		result, err := suite.FinalExp(millerLoop)
+		require.Nil(t, err)
+
+		expected, _ := suite.Pairing(pointG1.(*PointG1), pointG2.(*PointG2))
+		assert.False(t, expected.GT.IsEqual(millerLoop.GT))
+		assert.True(t, expected.GT.IsEqual(result.GT))
+	})
+	t.Run("product should be the product of the pairings", func(t *testing.T) {
+		points1 := make([]*PointG1, 3)
+		points2 := make([]*PointG2, 3)
+		expected := &bls.GT{}
+		expected.SetInt64(1)
+		for i := range points1 {
+			pointG1, _ := NewPointG1().Pick()
+			pointG2, _ := NewPointG2().Pick()
+			points1[i] = pointG1.(*PointG1)
+			points2[i] = pointG2.(*PointG2)
+
+			pairing, _ := suite.Pairing(points1[i], points2[i])
+			bls.GTMul(expected, expected, pairing.GT)
+		}
+
+		result, err := suite.PairingProduct(points1, points2)
+		require.Nil(t, err)
+		assert.True(t, expected.IsEqual(result.GT))
+	})
+}
+
+func TestSuiteBLS12_PairingProductIsOne(t *testing.T) {
+	t.Parallel()
+
+	suite := NewSuiteBLS12()
+
+	// e(sk*H(m), g2) == e(H(m), sk*g2), checked as e(sig, -g2) * e(H(m), pk) == 1
+	sk := NewScalar()
+	hashPoint, _ := NewPointG1().Pick()
+	sig, _ := hashPoint.Mul(sk)
+	pubKey, _ := NewPointG2().Mul(sk)
+	negGenerator := NewPointG2().Neg().(*PointG2)
+
+	t.Run("invalid points lists should err", func(t *testing.T) {
+		isOne, err := suite.PairingProductIsOne([]*PointG1{sig.(*PointG1)}, nil)
+		assert.False(t, isOne)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("valid equation should be one", func(t *testing.T) {
+		isOne, err := suite.PairingProductIsOne(
+			[]*PointG1{sig.(*PointG1), hashPoint.(*PointG1)},
+			[]*PointG2{negGenerator, pubKey.(*PointG2)},
+		)
+		require.Nil(t, err)
+		assert.True(t, isOne)
+	})
+	t.Run("invalid equation should not be one", func(t *testing.T) {
+		otherPubKey, _ := NewPointG2().Pick()
+		isOne, err := suite.PairingProductIsOne(
+			[]*PointG1{sig.(*PointG1), hashPoint.(*PointG1)},
+			[]*PointG2{negGenerator, otherPubKey.(*PointG2)},
+		)
+		require.Nil(t, err)
+		assert.False(t, isOne)
+	})
+}
+
+func TestIsPairingProductOne(t *testing.T) {
+	t.Parallel()
+
+	sk := NewScalar()
+	hashPoint, _ := NewPointG1().Pick()
+	sig, _ := hashPoint.Mul(sk)
+	pubKey, _ := NewPointG2().Mul(sk)
+	negGenerator := NewPointG2().Neg().(*PointG2)
+	otherPubKey, _ := NewPointG2().Pick()
+
+	pointsG1 := []bls.G1{*sig.(*PointG1).G1, *hashPoint.(*PointG1).G1}
+	assert.True(t, IsPairingProductOne(pointsG1, []bls.G2{*negGenerator.G2, *pubKey.(*PointG2).G2}))
+	assert.False(t, IsPairingProductOne(pointsG1, []bls.G2{*negGenerator.G2, *otherPubKey.(*PointG2).G2}))
+	assert.False(t, IsPairingProductOne(pointsG1, []bls.G2{*negGenerator.G2}))
+}
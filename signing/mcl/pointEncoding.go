@@ -0,0 +1,126 @@
+package mcl
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+// PointEncoding is a serialization format of the G1 and G2 points
+type PointEncoding uint8
+
+const (
+	// HerumiEncoding is the native serialization of the herumi library, used by MarshalBinary and by the chain
+	HerumiEncoding PointEncoding = iota
+	// ZCashCompressedEncoding is the ZCash compressed serialization, used by the IETF BLS signature draft and Ethereum
+	ZCashCompressedEncoding
+	// ZCashUncompressedEncoding is the ZCash uncompressed serialization
+	ZCashUncompressedEncoding
+	// EIP2537Encoding is the layout of the BLS12-381 precompiles of Ethereum
+	EIP2537Encoding
+)
+
+// EncodeG1 serializes the G1 point with the given encoding
+func EncodeG1(point *bls.G1, encoding PointEncoding) ([]byte, error) {
+	if point == nil {
+		return nil, crypto.ErrNilParam
+	}
+
+	switch encoding {
+	case HerumiEncoding:
+		return point.Serialize(), nil
+	case ZCashCompressedEncoding:
+		return G1ToZCashCompressed(point), nil
+	case ZCashUncompressedEncoding:
+		return G1ToZCashUncompressed(point), nil
+	case EIP2537Encoding:
+		return G1ToEIP2537(point), nil
+	default:
+		return nil, crypto.ErrInvalidParam
+	}
+}
+
+// DecodeG1 deserializes a G1 point with the given encoding. With all the encodings the point is checked to be on
+// the curve and in the G1 subgroup. The point at infinity is accepted
+func DecodeG1(data []byte, encoding PointEncoding) (*bls.G1, error) {
+	switch encoding {
+	case HerumiEncoding:
+		point := &bls.G1{}
+		err := point.Deserialize(data)
+		if err != nil {
+			return nil, crypto.ErrInvalidPoint
+		}
+
+		return validateG1(point)
+	case ZCashCompressedEncoding:
+		return G1FromZCashCompressed(data)
+	case ZCashUncompressedEncoding:
+		return G1FromZCashUncompressed(data)
+	case EIP2537Encoding:
+		return G1FromEIP2537(data)
+	default:
+		return nil, crypto.ErrInvalidParam
+	}
+}
+
+// ConvertG1Encoding converts a serialized G1 point from one encoding to another
+func ConvertG1Encoding(data []byte, from PointEncoding, to PointEncoding) ([]byte, error) {
+	point, err := DecodeG1(data, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeG1(point, to)
+}
+
+// EncodeG2 serializes the G2 point with the given encoding
+func EncodeG2(point *bls.G2, encoding PointEncoding) ([]byte, error) {
+	if point == nil {
+		return nil, crypto.ErrNilParam
+	}
+
+	switch encoding {
+	case HerumiEncoding:
+		return point.Serialize(), nil
+	case ZCashCompressedEncoding:
+		return G2ToZCashCompressed(point), nil
+	case ZCashUncompressedEncoding:
+		return G2ToZCashUncompressed(point), nil
+	case EIP2537Encoding:
+		return G2ToEIP2537(point), nil
+	default:
+		return nil, crypto.ErrInvalidParam
+	}
+}
+
+// DecodeG2 deserializes a G2 point with the given encoding. With all the encodings the point is checked to be on
+// the curve and in the G2 subgroup. The point at infinity is accepted
+func DecodeG2(data []byte, encoding PointEncoding) (*bls.G2, error) {
+	switch encoding {
+	case HerumiEncoding:
+		point := &bls.G2{}
+		err := point.Deserialize(data)
+		if err != nil {
+			return nil, crypto.ErrInvalidPoint
+		}
+
+		return validateG2(point)
+	case ZCashCompressedEncoding:
+		return G2FromZCashCompressed(data)
+	case ZCashUncompressedEncoding:
+		return G2FromZCashUncompressed(data)
+	case EIP2537Encoding:
+		return G2FromEIP2537(data)
+	default:
+		return nil, crypto.ErrInvalidParam
+	}
+}
+
+// ConvertG2Encoding converts a serialized G2 point from one encoding to another
+func ConvertG2Encoding(data []byte, from PointEncoding, to PointEncoding) ([]byte, error) {
+	point, err := DecodeG2(data, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeG2(point, to)
+}
@@ -0,0 +1,99 @@
+package mcl
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/require"
+)
+
+var allPointEncodings = []PointEncoding{HerumiEncoding, ZCashCompressedEncoding, ZCashUncompressedEncoding, EIP2537Encoding}
+
+func TestEncodeDecodeG1(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil point should err", func(t *testing.T) {
+		encoded, err := EncodeG1(nil, HerumiEncoding)
+		require.Nil(t, encoded)
+		require.Equal(t, crypto.ErrNilParam, err)
+	})
+	t.Run("unknown encoding should err", func(t *testing.T) {
+		encoded, err := EncodeG1(NewPointG1().G1, PointEncoding(100))
+		require.Nil(t, encoded)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+
+		point, err := DecodeG1(make([]byte, 48), PointEncoding(100))
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("invalid herumi point should err", func(t *testing.T) {
+		point, err := DecodeG1([]byte("invalid"), HerumiEncoding)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("herumi encoding should be MarshalBinary", func(t *testing.T) {
+		point := NewPointG1()
+		expected, _ := point.MarshalBinary()
+
+		encoded, err := EncodeG1(point.G1, HerumiEncoding)
+		require.Nil(t, err)
+		require.Equal(t, expected, encoded)
+	})
+}
+
+func TestConvertG1Encoding(t *testing.T) {
+	t.Parallel()
+
+	_, point := NewSuiteBLS12MinPubKey().CreateKeyPair()
+	g1 := point.(*PointG1).G1
+	zero := &bls.G1{}
+	zero.Clear()
+
+	for _, p := range []*bls.G1{g1, zero} {
+		for _, from := range allPointEncodings {
+			for _, to := range allPointEncodings {
+				data, _ := EncodeG1(p, from)
+				expected, _ := EncodeG1(p, to)
+
+				converted, err := ConvertG1Encoding(data, from, to)
+				require.Nil(t, err)
+				require.Equal(t, expected, converted)
+			}
+		}
+	}
+
+	converted, err := ConvertG1Encoding(make([]byte, 48), ZCashCompressedEncoding, HerumiEncoding)
+	require.Nil(t, converted)
+	require.Equal(t, crypto.ErrInvalidPoint, err)
+}
+
+func TestConvertG2Encoding(t *testing.T) {
+	t.Parallel()
+
+	_, point := NewSuiteBLS12().CreateKeyPair()
+	g2 := point.(*PointG2).G2
+	zero := &bls.G2{}
+	zero.Clear()
+
+	for _, p := range []*bls.G2{g2, zero} {
+		for _, from := range allPointEncodings {
+			for _, to := range allPointEncodings {
+				data, _ := EncodeG2(p, from)
+				expected, _ := EncodeG2(p, to)
+
+				converted, err := ConvertG2Encoding(data, from, to)
+				require.Nil(t, err)
+				require.Equal(t, expected, converted)
+			}
+		}
+	}
+
+	converted, err := ConvertG2Encoding(make([]byte, 96), ZCashCompressedEncoding, HerumiEncoding)
+	require.Nil(t, converted)
+	require.Equal(t, crypto.ErrInvalidPoint, err)
+
+	encoded, err := EncodeG2(nil, EIP2537Encoding)
+	require.Nil(t, encoded)
+	require.Equal(t, crypto.ErrNilParam, err)
+}
@@ -45,17 +45,11 @@ func (po *PointG1) Equal(p crypto.Point) (bool, error) {
 
 // Clone returns a clone of the receiver.
 func (po *PointG1) Clone() crypto.Point {
-	po2 := PointG1{
-		G1: &bls.G1{},
-	}
+	point := *po.G1
 
-	strPo := po.G1.GetString(16)
-	err := po2.G1.SetString(strPo, 16)
-	if err != nil {
-		log.Error("PointG1 Clone", "error", err.Error())
+	return &PointG1{
+		G1: &point,
 	}
-
-	return &po2
 }
 
 // Null returns the neutral identity element.
@@ -80,8 +74,21 @@ func (po *PointG1) Set(p crypto.Point) error {
 		return crypto.ErrInvalidParam
 	}
 
-	strPo := po1.G1.GetString(16)
-	return po.G1.SetString(strPo, 16)
+	return po.SetFrom(po1)
+}
+
+// SetFrom sets the receiver equal to the point p, by copying its coordinates
+func (po *PointG1) SetFrom(p *PointG1) error {
+	if p == nil || p.G1 == nil {
+		return crypto.ErrNilParam
+	}
+	if po.G1 == nil {
+		po.G1 = &bls.G1{}
+	}
+
+	*po.G1 = *p.G1
+
+	return nil
 }
 
 // Add returns the result of adding receiver with Point p given as parameter,
@@ -160,6 +167,40 @@ func (po *PointG1) Mul(s crypto.Scalar) (crypto.Point, error) {
 	return &po2, nil
 }
 
+// AddAssign sets the receiver to the sum of the receiver and the point p, without allocating a new point
+func (po *PointG1) AddAssign(p *PointG1) error {
+	if p == nil || p.G1 == nil {
+		return crypto.ErrNilParam
+	}
+
+	bls.G1Add(po.G1, po.G1, p.G1)
+
+	return nil
+}
+
+// SubAssign sets the receiver to the difference between the receiver and the point p, without allocating a new point
+func (po *PointG1) SubAssign(p *PointG1) error {
+	if p == nil || p.G1 == nil {
+		return crypto.ErrNilParam
+	}
+
+	bls.G1Sub(po.G1, po.G1, p.G1)
+
+	return nil
+}
+
+// MulAssign sets the receiver to the product of the receiver with the scalar s, without allocating a new point
+func (po *PointG1) MulAssign(s *Scalar) error {
+	if s == nil || s.Scalar == nil {
+		return crypto.ErrNilParam
+	}
+
+	bls.G1MulCT(po.G1, po.G1, s.Scalar)
+	runtime.KeepAlive(s)
+
+	return nil
+}
+
 // Pick returns a new random or pseudo-random Point.
 func (po *PointG1) Pick() (crypto.Point, error) {
 	scalar := &bls.Fr{}
@@ -169,7 +210,12 @@ func (po *PointG1) Pick() (crypto.Point, error) {
 		G1: &bls.G1{},
 	}
 
-	bls.G1MulCT(po2.G1, po.G1, scalar)
+	generatorTable := GeneratorTableG1()
+	if po.G1.IsEqual(&generatorTable.base) {
+		generatorTable.mulFr(po2.G1, scalar)
+	} else {
+		bls.G1MulCT(po2.G1, po.G1, scalar)
+	}
 	runtime.KeepAlive(scalar)
 
 	return &po2, nil
@@ -190,6 +236,24 @@ func (po *PointG1) UnmarshalBinary(point []byte) error {
 	return po.G1.Deserialize(point)
 }
 
+// MarshalBinaryWithEncoding converts the point into its byte array representation in the given encoding
+func (po *PointG1) MarshalBinaryWithEncoding(encoding PointEncoding) ([]byte, error) {
+	return EncodeG1(po.G1, encoding)
+}
+
+// UnmarshalBinaryWithEncoding reconstructs a point from its byte array representation in the given encoding.
+// The point is checked to be on the curve and in the G1 subgroup
+func (po *PointG1) UnmarshalBinaryWithEncoding(point []byte, encoding PointEncoding) error {
+	decoded, err := DecodeG1(point, encoding)
+	if err != nil {
+		return err
+	}
+
+	po.G1 = decoded
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (po *PointG1) IsInterfaceNil() bool {
 	return po == nil
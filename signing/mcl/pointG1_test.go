@@ -298,6 +298,31 @@ func TestPointG1_UnmarshalBinary(t *testing.T) {
 	assert.True(t, eq)
 }
 
+func TestPointG1_MarshalUnmarshalBinaryWithEncoding(t *testing.T) {
+	t.Parallel()
+
+	encodings := []PointEncoding{HerumiEncoding, ZCashCompressedEncoding, ZCashUncompressedEncoding, EIP2537Encoding}
+	for _, encoding := range encodings {
+		point1, _ := NewPointG1().Pick()
+		pointBytes, err := point1.(*PointG1).MarshalBinaryWithEncoding(encoding)
+		require.Nil(t, err)
+
+		point2 := NewPointG1()
+		err = point2.UnmarshalBinaryWithEncoding(pointBytes, encoding)
+		require.Nil(t, err)
+
+		eq, _ := point1.Equal(point2)
+		require.True(t, eq)
+	}
+
+	point := NewPointG1()
+	_, err := point.MarshalBinaryWithEncoding(PointEncoding(100))
+	require.Equal(t, crypto.ErrInvalidParam, err)
+
+	err = point.UnmarshalBinaryWithEncoding([]byte("invalid"), ZCashCompressedEncoding)
+	require.NotNil(t, err)
+}
+
 func TestPointG1_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -307,3 +332,170 @@ func TestPointG1_IsInterfaceNil(t *testing.T) {
 	point = NewPointG1()
 	require.False(t, check.IfNil(point))
 }
+
+func TestPointG1_CloneShouldNotShareMemory(t *testing.T) {
+	t.Parallel()
+
+	p1 := NewPointG1()
+	p2 := p1.Clone().(*PointG1)
+	require.NotSame(t, p1.G1, p2.G1)
+
+	bls.G1Dbl(p2.G1, p2.G1)
+	eq, err := p1.Equal(p2)
+	require.Nil(t, err)
+	require.False(t, eq)
+}
+
+func TestPointG1_SetFrom(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil param should err", func(t *testing.T) {
+		point := NewPointG1()
+		require.Equal(t, crypto.ErrNilParam, point.SetFrom(nil))
+		require.Equal(t, crypto.ErrNilParam, point.SetFrom(&PointG1{}))
+	})
+	t.Run("should copy the point", func(t *testing.T) {
+		p1 := NewPointG1()
+		err := p1.SetString(testPointG1Str, testPointG1StrBase)
+		require.Nil(t, err)
+
+		p2 := &PointG1{}
+		err = p2.SetFrom(p1)
+		require.Nil(t, err)
+		require.NotSame(t, p1.G1, p2.G1)
+		require.True(t, p1.IsEqual(p2.G1))
+	})
+}
+
+func TestPointG1_AddAssign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil param should err", func(t *testing.T) {
+		point := NewPointG1()
+		require.Equal(t, crypto.ErrNilParam, point.AddAssign(nil))
+	})
+	t.Run("should give the same result as Add", func(t *testing.T) {
+		p1, _ := NewPointG1().Pick()
+		p2, _ := NewPointG1().Pick()
+		expected, _ := p1.Add(p2)
+
+		point := p1.Clone().(*PointG1)
+		err := point.AddAssign(p2.(*PointG1))
+		require.Nil(t, err)
+
+		eq, _ := point.Equal(expected)
+		require.True(t, eq)
+	})
+	t.Run("adding the receiver to itself should double it", func(t *testing.T) {
+		p1, _ := NewPointG1().Pick()
+		expected, _ := p1.Add(p1)
+
+		point := p1.(*PointG1)
+		err := point.AddAssign(point)
+		require.Nil(t, err)
+
+		eq, _ := point.Equal(expected)
+		require.True(t, eq)
+	})
+}
+
+func TestPointG1_SubAssign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil param should err", func(t *testing.T) {
+		point := NewPointG1()
+		require.Equal(t, crypto.ErrNilParam, point.SubAssign(nil))
+	})
+	t.Run("should give the same result as Sub", func(t *testing.T) {
+		p1, _ := NewPointG1().Pick()
+		p2, _ := NewPointG1().Pick()
+		expected, _ := p1.Sub(p2)
+
+		point := p1.Clone().(*PointG1)
+		err := point.SubAssign(p2.(*PointG1))
+		require.Nil(t, err)
+
+		eq, _ := point.Equal(expected)
+		require.True(t, eq)
+	})
+	t.Run("subtracting the receiver from itself should give the identity", func(t *testing.T) {
+		p1, _ := NewPointG1().Pick()
+
+		point := p1.(*PointG1)
+		err := point.SubAssign(point)
+		require.Nil(t, err)
+		require.True(t, point.IsZero())
+	})
+}
+
+func TestPointG1_MulAssign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil param should err", func(t *testing.T) {
+		point := NewPointG1()
+		require.Equal(t, crypto.ErrNilParam, point.MulAssign(nil))
+	})
+	t.Run("should give the same result as Mul", func(t *testing.T) {
+		p1, _ := NewPointG1().Pick()
+		scalar, _ := NewScalar().Pick()
+		expected, _ := p1.Mul(scalar)
+
+		point := p1.Clone().(*PointG1)
+		err := point.MulAssign(scalar.(*Scalar))
+		require.Nil(t, err)
+
+		eq, _ := point.Equal(expected)
+		require.True(t, eq)
+	})
+}
+
+func TestPointG1_InPlaceOperationsShouldNotAllocate(t *testing.T) {
+	p1, _ := NewPointG1().Pick()
+	p2, _ := NewPointG1().Pick()
+	scalar, _ := NewScalar().Pick()
+	point := p1.(*PointG1)
+	other := p2.(*PointG1)
+	mclScalar := scalar.(*Scalar)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = point.AddAssign(other)
+		_ = point.SubAssign(other)
+		_ = point.MulAssign(mclScalar)
+		_ = point.SetFrom(other)
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkPointG1_Add(b *testing.B) {
+	p1, _ := NewPointG1().Pick()
+	p2, _ := NewPointG1().Pick()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p1, _ = p1.Add(p2)
+	}
+}
+
+func BenchmarkPointG1_AddAssign(b *testing.B) {
+	p1, _ := NewPointG1().Pick()
+	p2, _ := NewPointG1().Pick()
+	point := p1.(*PointG1)
+	other := p2.(*PointG1)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = point.AddAssign(other)
+	}
+}
+
+func BenchmarkPointG1_Clone(b *testing.B) {
+	point, _ := NewPointG1().Pick()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = point.Clone()
+	}
+}
@@ -45,17 +45,11 @@ func (po *PointG2) Equal(p crypto.Point) (bool, error) {
 
 // Clone returns a clone of the receiver.
 func (po *PointG2) Clone() crypto.Point {
-	po2 := PointG2{
-		G2: &bls.G2{},
-	}
+	point := *po.G2
 
-	strPo := po.G2.GetString(16)
-	err := po2.G2.SetString(strPo, 16)
-	if err != nil {
-		log.Error("PointG2 Clone", "error", err.Error())
+	return &PointG2{
+		G2: &point,
 	}
-
-	return &po2
 }
 
 // Null returns the neutral identity element.
@@ -80,8 +74,21 @@ func (po *PointG2) Set(p crypto.Point) error {
 		return crypto.ErrInvalidParam
 	}
 
-	strPo := po1.G2.GetString(16)
-	return po.G2.SetString(strPo, 16)
+	return po.SetFrom(po1)
+}
+
+// SetFrom sets the receiver equal to the point p, by copying its coordinates
+func (po *PointG2) SetFrom(p *PointG2) error {
+	if p == nil || p.G2 == nil {
+		return crypto.ErrNilParam
+	}
+	if po.G2 == nil {
+		po.G2 = &bls.G2{}
+	}
+
+	*po.G2 = *p.G2
+
+	return nil
 }
 
 // Add returns the result of adding receiver with Point p given as parameter,
@@ -160,6 +167,40 @@ func (po *PointG2) Mul(s crypto.Scalar) (crypto.Point, error) {
 	return &po2, nil
 }
 
+// AddAssign sets the receiver to the sum of the receiver and the point p, without allocating a new point
+func (po *PointG2) AddAssign(p *PointG2) error {
+	if p == nil || p.G2 == nil {
+		return crypto.ErrNilParam
+	}
+
+	bls.G2Add(po.G2, po.G2, p.G2)
+
+	return nil
+}
+
+// SubAssign sets the receiver to the difference between the receiver and the point p, without allocating a new point
+func (po *PointG2) SubAssign(p *PointG2) error {
+	if p == nil || p.G2 == nil {
+		return crypto.ErrNilParam
+	}
+
+	bls.G2Sub(po.G2, po.G2, p.G2)
+
+	return nil
+}
+
+// MulAssign sets the receiver to the product of the receiver with the scalar s, without allocating a new point
+func (po *PointG2) MulAssign(s *Scalar) error {
+	if s == nil || s.Scalar == nil {
+		return crypto.ErrNilParam
+	}
+
+	bls.G2Mul(po.G2, po.G2, s.Scalar)
+	runtime.KeepAlive(s)
+
+	return nil
+}
+
 // Pick returns a new random or pseudo-random Point.
 func (po *PointG2) Pick() (crypto.Point, error) {
 	scalar := &bls.Fr{}
@@ -169,7 +210,12 @@ func (po *PointG2) Pick() (crypto.Point, error) {
 		G2: &bls.G2{},
 	}
 
-	bls.G2Mul(po2.G2, po.G2, scalar)
+	generatorTable := GeneratorTableG2()
+	if po.G2.IsEqual(&generatorTable.base) {
+		generatorTable.mulFr(po2.G2, scalar)
+	} else {
+		bls.G2Mul(po2.G2, po.G2, scalar)
+	}
 	runtime.KeepAlive(scalar)
 
 	return &po2, nil
@@ -190,6 +236,24 @@ func (po *PointG2) UnmarshalBinary(point []byte) error {
 	return po.G2.Deserialize(point)
 }
 
+// MarshalBinaryWithEncoding converts the point into its byte array representation in the given encoding
+func (po *PointG2) MarshalBinaryWithEncoding(encoding PointEncoding) ([]byte, error) {
+	return EncodeG2(po.G2, encoding)
+}
+
+// UnmarshalBinaryWithEncoding reconstructs a point from its byte array representation in the given encoding.
+// The point is checked to be on the curve and in the G2 subgroup
+func (po *PointG2) UnmarshalBinaryWithEncoding(point []byte, encoding PointEncoding) error {
+	decoded, err := DecodeG2(point, encoding)
+	if err != nil {
+		return err
+	}
+
+	po.G2 = decoded
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (po *PointG2) IsInterfaceNil() bool {
 	return po == nil
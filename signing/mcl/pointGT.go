@@ -37,17 +37,11 @@ func (po *PointGT) Equal(p crypto.Point) (bool, error) {
 
 // Clone returns a clone of the receiver.
 func (po *PointGT) Clone() crypto.Point {
-	po2 := PointGT{
-		GT: &bls.GT{},
-	}
+	point := *po.GT
 
-	strPo := po.GT.GetString(16)
-	err := po2.SetString(strPo, 16)
-	if err != nil {
-		log.Error("PointGT Clone", "error", err.Error())
+	return &PointGT{
+		GT: &point,
 	}
-
-	return &po2
 }
 
 // Null returns the neutral identity element.
@@ -69,8 +63,11 @@ func (po *PointGT) Set(p crypto.Point) error {
 		return crypto.ErrInvalidParam
 	}
 
-	point := po1.Clone().(*PointGT)
-	po.GT = point.GT
+	if po.GT == nil {
+		po.GT = &bls.GT{}
+	}
+
+	*po.GT = *po1.GT
 
 	return nil
 }
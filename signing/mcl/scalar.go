@@ -1,6 +1,7 @@
 package mcl
 
 import (
+	"crypto/rand"
 	"runtime"
 
 	"github.com/herumi/bls-go-binary/bls"
@@ -10,6 +11,9 @@ import (
 
 var _ crypto.Scalar = (*Scalar)(nil)
 
+// shortScalarSize is the size in bytes of the random scalars of the batch verifications
+const shortScalarSize = 8
+
 // Scalar -
 type Scalar struct {
 	Scalar *bls.Fr
@@ -54,21 +58,30 @@ func (sc *Scalar) Set(s crypto.Scalar) error {
 		return crypto.ErrInvalidParam
 	}
 
-	return sc.Scalar.Deserialize(s2.Scalar.Serialize())
+	return sc.SetFrom(s2)
+}
+
+// SetFrom sets the receiver to the scalar s, by copying its value
+func (sc *Scalar) SetFrom(s *Scalar) error {
+	if s == nil || s.Scalar == nil {
+		return crypto.ErrNilParam
+	}
+	if sc.Scalar == nil {
+		sc.Scalar = &bls.Fr{}
+	}
+
+	*sc.Scalar = *s.Scalar
+
+	return nil
 }
 
 // Clone creates a new Scalar with same value as receiver
 func (sc *Scalar) Clone() crypto.Scalar {
-	s := Scalar{
-		Scalar: &bls.Fr{},
-	}
+	fr := *sc.Scalar
 
-	err := s.Scalar.Deserialize(sc.Scalar.Serialize())
-	if err != nil {
-		log.Error("MclScalar Clone", "error", err.Error())
+	return &Scalar{
+		Scalar: &fr,
 	}
-
-	return &s
 }
 
 // SetInt64 sets the receiver to a small integer value v given as parameter
@@ -213,6 +226,28 @@ func (sc *Scalar) Inv(s crypto.Scalar) (crypto.Scalar, error) {
 	return &s1, nil
 }
 
+// AddAssign sets the receiver to the modular sum of the receiver and the scalar s, without allocating a new scalar
+func (sc *Scalar) AddAssign(s *Scalar) error {
+	if s == nil || s.Scalar == nil {
+		return crypto.ErrNilParam
+	}
+
+	bls.FrAdd(sc.Scalar, sc.Scalar, s.Scalar)
+
+	return nil
+}
+
+// MulAssign sets the receiver to the modular product of the receiver and the scalar s, without allocating a new scalar
+func (sc *Scalar) MulAssign(s *Scalar) error {
+	if s == nil || s.Scalar == nil {
+		return crypto.ErrNilParam
+	}
+
+	bls.FrMul(sc.Scalar, sc.Scalar, s.Scalar)
+
+	return nil
+}
+
 // Pick returns a fresh random or pseudo-random scalarInt
 func (sc *Scalar) Pick() (crypto.Scalar, error) {
 	s1 := Scalar{
@@ -264,6 +299,27 @@ func (sc *Scalar) UnmarshalBinary(s []byte) error {
 	return sc.Scalar.Deserialize(s)
 }
 
+// SetRandomNonZeroShortScalar sets r to a random non-zero scalar of 64 bits. The random linear combinations of the batch
+// verifications use such scalars, which give a 2^-64 probability for invalid elements to pass the check, while keeping
+// the scalar multiplications short. They must not be used as secret keys
+func SetRandomNonZeroShortScalar(r *bls.Fr) error {
+	buff := [shortScalarSize]byte{}
+	r.Clear()
+	for r.IsZero() {
+		_, err := rand.Read(buff[:])
+		if err != nil {
+			return err
+		}
+
+		err = r.SetLittleEndian(buff[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sc *Scalar) IsInterfaceNil() bool {
 	return sc == nil
@@ -3,6 +3,7 @@ package mcl
 import (
 	"testing"
 
+	"github.com/herumi/bls-go-binary/bls"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/mock"
 	"github.com/stretchr/testify/require"
@@ -448,3 +449,138 @@ func TestMclScalar_UnmarshalBinary(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, eq)
 }
+
+func TestMclScalar_CloneShouldNotShareMemory(t *testing.T) {
+	t.Parallel()
+
+	scalar1 := NewScalar().One().(*Scalar)
+	scalar2 := scalar1.Clone().(*Scalar)
+	require.NotSame(t, scalar1.Scalar, scalar2.Scalar)
+
+	scalar2.Scalar.SetInt64(2)
+	eq, err := scalar1.Equal(scalar2)
+	require.Nil(t, err)
+	require.False(t, eq)
+}
+
+func TestMclScalar_SetFrom(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil param should err", func(t *testing.T) {
+		scalar := NewScalar()
+		require.Equal(t, crypto.ErrNilParam, scalar.SetFrom(nil))
+		require.Equal(t, crypto.ErrNilParam, scalar.SetFrom(&Scalar{}))
+	})
+	t.Run("should copy the scalar", func(t *testing.T) {
+		scalar1, _ := NewScalar().Pick()
+		scalar2 := &Scalar{}
+
+		err := scalar2.SetFrom(scalar1.(*Scalar))
+		require.Nil(t, err)
+		require.NotSame(t, scalar1.(*Scalar).Scalar, scalar2.Scalar)
+
+		eq, _ := scalar1.Equal(scalar2)
+		require.True(t, eq)
+	})
+}
+
+func TestMclScalar_AddAssign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil param should err", func(t *testing.T) {
+		scalar := NewScalar()
+		require.Equal(t, crypto.ErrNilParam, scalar.AddAssign(nil))
+	})
+	t.Run("should give the same result as Add", func(t *testing.T) {
+		scalar1, _ := NewScalar().Pick()
+		scalar2, _ := NewScalar().Pick()
+		expected, _ := scalar1.Add(scalar2)
+
+		scalar := scalar1.Clone().(*Scalar)
+		err := scalar.AddAssign(scalar2.(*Scalar))
+		require.Nil(t, err)
+
+		eq, _ := scalar.Equal(expected)
+		require.True(t, eq)
+	})
+}
+
+func TestMclScalar_MulAssign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil param should err", func(t *testing.T) {
+		scalar := NewScalar()
+		require.Equal(t, crypto.ErrNilParam, scalar.MulAssign(nil))
+	})
+	t.Run("should give the same result as Mul", func(t *testing.T) {
+		scalar1, _ := NewScalar().Pick()
+		scalar2, _ := NewScalar().Pick()
+		expected, _ := scalar1.Mul(scalar2)
+
+		scalar := scalar1.Clone().(*Scalar)
+		err := scalar.MulAssign(scalar2.(*Scalar))
+		require.Nil(t, err)
+
+		eq, _ := scalar.Equal(expected)
+		require.True(t, eq)
+	})
+	t.Run("multiplying the receiver by itself should square it", func(t *testing.T) {
+		scalar1, _ := NewScalar().Pick()
+		expected, _ := scalar1.Mul(scalar1)
+
+		scalar := scalar1.(*Scalar)
+		err := scalar.MulAssign(scalar)
+		require.Nil(t, err)
+
+		eq, _ := scalar.Equal(expected)
+		require.True(t, eq)
+	})
+}
+
+func TestMclScalar_InPlaceOperationsShouldNotAllocate(t *testing.T) {
+	scalar1, _ := NewScalar().Pick()
+	scalar2, _ := NewScalar().Pick()
+	scalar := scalar1.(*Scalar)
+	other := scalar2.(*Scalar)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = scalar.AddAssign(other)
+		_ = scalar.MulAssign(other)
+		_ = scalar.SetFrom(other)
+	})
+	require.Zero(t, allocs)
+}
+
+func TestSetRandomNonZeroShortScalar(t *testing.T) {
+	t.Parallel()
+
+	maxShortScalar := &bls.Fr{}
+	err := maxShortScalar.SetString("ffffffffffffffff", 16)
+	require.Nil(t, err)
+
+	previous := &bls.Fr{}
+	for i := 0; i < 100; i++ {
+		r := &bls.Fr{}
+		r.SetInt64(0)
+		err = SetRandomNonZeroShortScalar(r)
+		require.Nil(t, err)
+		require.False(t, r.IsZero())
+		require.False(t, r.IsEqual(previous))
+
+		// r fits in 64 bits, so it is serialized on the first 8 little endian bytes
+		serialized := r.Serialize()
+		require.Equal(t, make([]byte, len(serialized)-shortScalarSize), serialized[shortScalarSize:])
+
+		previous = r
+	}
+}
+
+func BenchmarkMclScalar_Clone(b *testing.B) {
+	scalar, _ := NewScalar().Pick()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = scalar.Clone()
+	}
+}
@@ -1,9 +1,12 @@
 package singlesig
 
 import (
+	"runtime"
+
 	"github.com/herumi/bls-go-binary/bls"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 )
 
@@ -11,13 +14,31 @@ var _ crypto.SingleSigner = (*BlsSingleSigner)(nil)
 
 // BlsSingleSigner is a SingleSigner implementation that uses a BLS signature scheme
 type BlsSingleSigner struct {
+	dst []byte
+	// PairingLines is optional, if set the verifications use the precomputed pairing lines of the generator and of the
+	// public keys registered in it, instead of computing them on every verification
+	PairingLines *mcl.PairingLinesCache
 }
 
-// NewBlsSigner creates a BLS single signer instance
+// NewBlsSigner creates a BLS single signer instance that maps the messages to G1 with the default mapping of the
+// herumi library
 func NewBlsSigner() *BlsSingleSigner {
 	return &BlsSingleSigner{}
 }
 
+// NewBlsSignerWithDST creates a BLS single signer instance that hashes the messages to G1 as defined in RFC 9380
+// (BLS12381G1_XMD:SHA-256_SSWU_RO_), with the given domain separation tag. Signers created with different tags
+// produce signatures that are not valid for each other
+func NewBlsSignerWithDST(dst []byte) (*BlsSingleSigner, error) {
+	if len(dst) == 0 || len(dst) > mcl.MaxDSTLen {
+		return nil, crypto.ErrInvalidDomainSeparationTag
+	}
+
+	return &BlsSingleSigner{
+		dst: append([]byte{}, dst...),
+	}, nil
+}
+
 // Sign Signs a message using a single signature BLS scheme
 func (s *BlsSingleSigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
 	if check.IfNil(private) {
@@ -37,8 +58,21 @@ func (s *BlsSingleSigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, e
 		return nil, crypto.ErrInvalidPrivateKey
 	}
 
-	sk := bls.CastToSecretKey(mclScalar.Scalar)
-	sig := sk.Sign(string(msg))
+	if len(s.dst) == 0 {
+		sk := bls.CastToSecretKey(mclScalar.Scalar)
+		sig := sk.Sign(string(msg))
+
+		return sig.Serialize(), nil
+	}
+
+	hashPoint, err := mcl.HashToG1(msg, s.dst)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := &bls.G1{}
+	bls.G1MulCT(sig, hashPoint, mclScalar.Scalar)
+	runtime.KeepAlive(mclScalar)
 
 	return sig.Serialize(), nil
 }
@@ -61,7 +95,7 @@ func (s *BlsSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte
 	}
 
 	pubKeyPoint, isPoint := point.(*mcl.PointG2)
-	if !isPoint || !IsPubKeyPointValid(pubKeyPoint) {
+	if !isPoint || !IsPubKeyValid(public, pubKeyPoint) {
 		return crypto.ErrInvalidPublicKey
 	}
 
@@ -77,11 +111,76 @@ func (s *BlsSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte
 		return crypto.ErrBLSInvalidSignature
 	}
 
-	if signature.Verify(mclPubKey, string(msg)) {
-		return nil
+	if !check.IfNil(s.PairingLines) {
+		return s.verifyWithPairingLines(bls.CastFromSign(signature), pubKeyPoint, msg)
+	}
+
+	if len(s.dst) == 0 {
+		if signature.Verify(mclPubKey, string(msg)) {
+			return nil
+		}
+
+		return crypto.ErrSigNotValid
+	}
+
+	hashPoint, err := mcl.HashToG1(msg, s.dst)
+	if err != nil {
+		return err
+	}
+
+	if !IsPairingValid(bls.CastFromSign(signature), hashPoint, pubKeyPoint.G2) {
+		return crypto.ErrSigNotValid
+	}
+
+	return nil
+}
+
+// HashToG1 maps the message to G1 with the hashing configured for the signer
+func (s *BlsSingleSigner) HashToG1(msg []byte) (*bls.G1, error) {
+	if len(s.dst) == 0 {
+		hashPoint := &bls.G1{}
+		err := hashPoint.HashAndMapTo(msg)
+
+		return hashPoint, err
+	}
+
+	return mcl.HashToG1(msg, s.dst)
+}
+
+// verifyWithPairingLines checks e(sig, g2) == e(H(msg), pubKey) with the pairing lines cache of the signer
+func (s *BlsSingleSigner) verifyWithPairingLines(sig *bls.G1, pubKey *mcl.PointG2, msg []byte) error {
+	hashPoint, err := s.HashToG1(msg)
+	if err != nil {
+		return err
+	}
+
+	isValid, err := s.PairingLines.VerifyPairing(&mcl.PointG1{G1: sig}, &mcl.PointG1{G1: hashPoint}, pubKey)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return crypto.ErrSigNotValid
+	}
+
+	return nil
+}
+
+// IsPairingValid checks e(sig, g2) == e(hashPoint, pubKey), as e(sig, -g2) * e(hashPoint, pubKey) == 1
+func IsPairingValid(sig *bls.G1, hashPoint *bls.G1, pubKey *bls.G2) bool {
+	pointsG1 := []bls.G1{*sig, *hashPoint}
+	pointsG2 := []bls.G2{*negatedGeneratorG2(), *pubKey}
+
+	return mcl.IsPairingProductOne(pointsG1, pointsG2)
+}
+
+// IsPubKeyValid validates the public key point is a valid point on G2, unless the public key was already validated
+// when it was decoded, as the ones taken from a public keys cache
+func IsPubKeyValid(pubKey crypto.PublicKey, pubKeyPoint *mcl.PointG2) bool {
+	if signing.IsValidatedPublicKey(pubKey) {
+		return true
 	}
 
-	return crypto.ErrSigNotValid
+	return IsPubKeyPointValid(pubKeyPoint)
 }
 
 // IsPubKeyPointValid validates the public key is a valid point on G2
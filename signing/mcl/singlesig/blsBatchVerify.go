@@ -0,0 +1,143 @@
+package singlesig
+
+import (
+	"sort"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+)
+
+/*
+Batch verification of independent BLS single signatures (pk_i, m_i, sig_i), i = 1..n.
+
+Instead of checking e(sig_i, g2) == e(H(m_i), pk_i) for every entry, which costs two pairings per signature, random
+non-zero scalars r_i are picked and the whole batch is checked with a single multi-pairing:
+
+	e(sum(r_i*sig_i), -g2) * prod(e(r_i*H(m_i), pk_i)) == 1
+
+The random scalars prevent an attacker from crafting invalid signatures that cancel each other inside the batch.
+If the batch check fails, the entries are verified one by one to find the ones that are not valid.
+*/
+
+// BatchVerify verifies a batch of independent BLS single signatures, where signatures[i] is the signature of
+// messages[i] with publicKeys[i]. If all signatures are valid it returns nil, nil. If some of the entries are not
+// valid, it returns their indexes in ascending order together with crypto.ErrSigNotValid
+func (s *BlsSingleSigner) BatchVerify(publicKeys []crypto.PublicKey, messages [][]byte, signatures [][]byte) ([]int, error) {
+	if len(publicKeys) == 0 {
+		return nil, crypto.ErrNilPublicKeys
+	}
+	if len(signatures) == 0 {
+		return nil, crypto.ErrNilSignaturesList
+	}
+	if len(publicKeys) != len(messages) || len(publicKeys) != len(signatures) {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	invalidIndexes := make([]int, 0)
+	batchIndexes := make([]int, 0, len(signatures))
+	scaledHashes := make([]bls.G1, 0, len(signatures)+1)
+	pubKeysG2 := make([]bls.G2, 0, len(signatures)+1)
+	sigsG1 := make([]bls.G1, 0, len(signatures))
+	randScalars := make([]bls.Fr, 0, len(signatures))
+
+	// first slot is reserved for the aggregated weighted signature paired with the negated generator
+	scaledHashes = append(scaledHashes, bls.G1{})
+	pubKeysG2 = append(pubKeysG2, *negatedGeneratorG2())
+
+	for i := range signatures {
+		pubKeyG2, sigG1, err := batchEntryToPoints(publicKeys[i], messages[i], signatures[i])
+		if err != nil {
+			invalidIndexes = append(invalidIndexes, i)
+			continue
+		}
+
+		hashG1, err := s.HashToG1(messages[i])
+		if err != nil {
+			invalidIndexes = append(invalidIndexes, i)
+			continue
+		}
+
+		r := &bls.Fr{}
+		err = mcl.SetRandomNonZeroShortScalar(r)
+		if err != nil {
+			return nil, err
+		}
+
+		scaledHash := bls.G1{}
+		bls.G1Mul(&scaledHash, hashG1, r)
+
+		batchIndexes = append(batchIndexes, i)
+		scaledHashes = append(scaledHashes, scaledHash)
+		pubKeysG2 = append(pubKeysG2, *pubKeyG2)
+		sigsG1 = append(sigsG1, *sigG1)
+		randScalars = append(randScalars, *r)
+	}
+
+	if len(batchIndexes) > 0 && !isBatchValid(scaledHashes, pubKeysG2, sigsG1, randScalars) {
+		for _, idx := range batchIndexes {
+			if s.Verify(publicKeys[idx], messages[idx], signatures[idx]) != nil {
+				invalidIndexes = append(invalidIndexes, idx)
+			}
+		}
+	}
+
+	if len(invalidIndexes) == 0 {
+		return nil, nil
+	}
+
+	sort.Ints(invalidIndexes)
+
+	return invalidIndexes, crypto.ErrSigNotValid
+}
+
+func batchEntryToPoints(publicKey crypto.PublicKey, message []byte, signature []byte) (*bls.G2, *bls.G1, error) {
+	if check.IfNil(publicKey) {
+		return nil, nil, crypto.ErrNilPublicKey
+	}
+	if len(message) == 0 {
+		return nil, nil, crypto.ErrNilMessage
+	}
+	if len(signature) == 0 {
+		return nil, nil, crypto.ErrNilSignature
+	}
+
+	point := publicKey.Point()
+	if check.IfNil(point) {
+		return nil, nil, crypto.ErrNilPublicKeyPoint
+	}
+
+	pubKeyPoint, isPoint := point.(*mcl.PointG2)
+	if !isPoint || !IsPubKeyValid(publicKey, pubKeyPoint) {
+		return nil, nil, crypto.ErrInvalidPublicKey
+	}
+
+	sig := &bls.Sign{}
+	err := sig.Deserialize(signature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !IsSigValidPoint(sig) {
+		return nil, nil, crypto.ErrBLSInvalidSignature
+	}
+
+	return pubKeyPoint.G2, bls.CastFromSign(sig), nil
+}
+
+// isBatchValid checks e(sum(r_i*sig_i), -g2) * prod(e(r_i*H(m_i), pk_i)) == 1.
+// The first element of scaledHashes is overwritten with the aggregated weighted signature
+func isBatchValid(scaledHashes []bls.G1, pubKeysG2 []bls.G2, sigsG1 []bls.G1, randScalars []bls.Fr) bool {
+	bls.G1MulVec(&scaledHashes[0], sigsG1, randScalars)
+
+	return mcl.IsPairingProductOne(scaledHashes, pubKeysG2)
+}
+
+func negatedGeneratorG2() *bls.G2 {
+	generator := mcl.NewPointG2()
+	negGenerator := &bls.G2{}
+	bls.G2Neg(negGenerator, generator.G2)
+
+	return negGenerator
+}
@@ -0,0 +1,146 @@
+package singlesig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/stretchr/testify/require"
+)
+
+func createBatch(t testing.TB, nbEntries int) ([]crypto.PublicKey, [][]byte, [][]byte) {
+	return createBatchWithSigner(t, singlesig.NewBlsSigner(), nbEntries)
+}
+
+func createBatchWithSigner(t testing.TB, signer crypto.SingleSigner, nbEntries int) ([]crypto.PublicKey, [][]byte, [][]byte) {
+	suite := mcl.NewSuiteBLS12()
+	kg := signing.NewKeyGenerator(suite)
+
+	pubKeys := make([]crypto.PublicKey, nbEntries)
+	messages := make([][]byte, nbEntries)
+	signatures := make([][]byte, nbEntries)
+	for i := 0; i < nbEntries; i++ {
+		privKey, pubKey := kg.GeneratePair()
+		messages[i] = []byte(fmt.Sprintf("message %d", i))
+		sig, err := signer.Sign(privKey, messages[i])
+		require.Nil(t, err)
+
+		pubKeys[i] = pubKey
+		signatures[i] = sig
+	}
+
+	return pubKeys, messages, signatures
+}
+
+func TestBlsSingleSigner_BatchVerifyInvalidParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	pubKeys, messages, signatures := createBatch(t, 3)
+
+	invalid, err := signer.BatchVerify(nil, messages, signatures)
+	require.Nil(t, invalid)
+	require.Equal(t, crypto.ErrNilPublicKeys, err)
+
+	invalid, err = signer.BatchVerify(pubKeys, messages, nil)
+	require.Nil(t, invalid)
+	require.Equal(t, crypto.ErrNilSignaturesList, err)
+
+	invalid, err = signer.BatchVerify(pubKeys, messages[:2], signatures)
+	require.Nil(t, invalid)
+	require.Equal(t, crypto.ErrInvalidParam, err)
+
+	invalid, err = signer.BatchVerify(pubKeys, messages, signatures[:2])
+	require.Nil(t, invalid)
+	require.Equal(t, crypto.ErrInvalidParam, err)
+}
+
+func TestBlsSingleSigner_BatchVerifyOK(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	pubKeys, messages, signatures := createBatch(t, 20)
+
+	invalid, err := signer.BatchVerify(pubKeys, messages, signatures)
+	require.Nil(t, err)
+	require.Nil(t, invalid)
+}
+
+func TestBlsSingleSigner_BatchVerifyWithDSTOK(t *testing.T) {
+	t.Parallel()
+
+	signer, _ := singlesig.NewBlsSignerWithDST([]byte(testDST))
+	pubKeys, messages, signatures := createBatchWithSigner(t, signer, 20)
+
+	invalid, err := signer.BatchVerify(pubKeys, messages, signatures)
+	require.Nil(t, err)
+	require.Nil(t, invalid)
+
+	invalid, err = singlesig.NewBlsSigner().BatchVerify(pubKeys, messages, signatures)
+	require.Equal(t, crypto.ErrSigNotValid, err)
+	require.Equal(t, 20, len(invalid))
+}
+
+func TestBlsSingleSigner_BatchVerifyWrongSignaturesShouldReturnIndexes(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	pubKeys, messages, signatures := createBatch(t, 10)
+
+	// swapped signatures are valid points but sign other messages
+	signatures[2], signatures[7] = signatures[7], signatures[2]
+
+	invalid, err := signer.BatchVerify(pubKeys, messages, signatures)
+	require.Equal(t, crypto.ErrSigNotValid, err)
+	require.Equal(t, []int{2, 7}, invalid)
+}
+
+func TestBlsSingleSigner_BatchVerifyMalformedEntriesShouldReturnIndexes(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	pubKeys, messages, signatures := createBatch(t, 6)
+
+	pubKeys[0] = nil
+	messages[1] = nil
+	signatures[3] = []byte("not a signature")
+	signatures[4] = nil
+
+	invalid, err := signer.BatchVerify(pubKeys, messages, signatures)
+	require.Equal(t, crypto.ErrSigNotValid, err)
+	require.Equal(t, []int{0, 1, 3, 4}, invalid)
+}
+
+func TestBlsSingleSigner_BatchVerifyCancellingSignaturesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	pubKeys, messages, signatures := createBatch(t, 2)
+
+	sig0 := mcl.NewPointG1()
+	err := sig0.UnmarshalBinary(signatures[0])
+	require.Nil(t, err)
+	sig1 := mcl.NewPointG1()
+	err = sig1.UnmarshalBinary(signatures[1])
+	require.Nil(t, err)
+
+	// the sum of the two forged signatures is still the sum of the valid ones
+	delta, err := mcl.NewPointG1().Pick()
+	require.Nil(t, err)
+	forged0, err := sig0.Add(delta)
+	require.Nil(t, err)
+	forged1, err := sig1.Sub(delta)
+	require.Nil(t, err)
+
+	signatures[0], err = forged0.MarshalBinary()
+	require.Nil(t, err)
+	signatures[1], err = forged1.MarshalBinary()
+	require.Nil(t, err)
+
+	invalid, err := signer.BatchVerify(pubKeys, messages, signatures)
+	require.Equal(t, crypto.ErrSigNotValid, err)
+	require.Equal(t, []int{0, 1}, invalid)
+}
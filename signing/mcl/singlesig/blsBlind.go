@@ -0,0 +1,171 @@
+package singlesig
+
+import (
+	"runtime"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+)
+
+/*
+Blind BLS signatures, where the signer signs a message without learning it:
+
+	user:   blinded = r*H(m), with r a random non-zero scalar
+	signer: blindSig = sk*blinded
+	user:   sig = r^-1*blindSig = sk*H(m)
+
+The unblinded signature is a regular BLS signature of m, verified by Verify. As r is uniformly random, the blinded
+point is uniformly random too and the signer can not link the signatures it sees later to the signing sessions.
+
+The signer signs any point it receives, so the key used for blind signing must not be used for anything else: the
+holder of a blind signing session can obtain the signature of any message of its choice.
+*/
+
+// Blind hashes the message to G1 with the hashing configured for the signer and blinds the hash with a random
+// scalar. It returns the blinded message, to be sent to the signer, and the blinding factor, to be kept secret by
+// the user until the blind signature is unblinded
+func (s *BlsSingleSigner) Blind(msg []byte) ([]byte, *mcl.Scalar, error) {
+	if len(msg) == 0 {
+		return nil, nil, crypto.ErrNilMessage
+	}
+
+	hashPoint, err := s.HashToG1(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blindingFactor, err := mcl.NewScalar().Pick()
+	if err != nil {
+		return nil, nil, err
+	}
+	mclBlindingFactor := blindingFactor.(*mcl.Scalar)
+	if mclBlindingFactor.Scalar.IsZero() {
+		return nil, nil, crypto.ErrInvalidScalar
+	}
+
+	blinded := &bls.G1{}
+	bls.G1MulCT(blinded, hashPoint, mclBlindingFactor.Scalar)
+
+	return blinded.Serialize(), mclBlindingFactor, nil
+}
+
+// SignBlinded signs the blinded message with the private key. The signer learns nothing about the message
+func (s *BlsSingleSigner) SignBlinded(private crypto.PrivateKey, blindedMsg []byte) ([]byte, error) {
+	if check.IfNil(private) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+
+	scalar := private.Scalar()
+	if check.IfNil(scalar) {
+		return nil, crypto.ErrNilPrivateKeyScalar
+	}
+
+	mclScalar, ok := scalar.(*mcl.Scalar)
+	if !ok || !IsSecretKeyValid(mclScalar) {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	blindedPoint, err := g1PointFromBytes(blindedMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	blindSig := &bls.G1{}
+	bls.G1MulCT(blindSig, blindedPoint, mclScalar.Scalar)
+	runtime.KeepAlive(mclScalar)
+
+	return blindSig.Serialize(), nil
+}
+
+// VerifyBlinded verifies the blind signature of the blinded message against the public key, so the user can check
+// the answer of the signer before unblinding it
+func (s *BlsSingleSigner) VerifyBlinded(public crypto.PublicKey, blindedMsg []byte, blindSig []byte) error {
+	if check.IfNil(public) {
+		return crypto.ErrNilPublicKey
+	}
+
+	point := public.Point()
+	if check.IfNil(point) {
+		return crypto.ErrNilPublicKeyPoint
+	}
+
+	pubKeyPoint, isPoint := point.(*mcl.PointG2)
+	if !isPoint || !IsPubKeyValid(public, pubKeyPoint) {
+		return crypto.ErrInvalidPublicKey
+	}
+
+	blindedPoint, err := g1PointFromBytes(blindedMsg)
+	if err != nil {
+		return err
+	}
+
+	blindSigPoint, err := blindSigBytesToPoint(blindSig)
+	if err != nil {
+		return err
+	}
+
+	if !IsPairingValid(blindSigPoint, blindedPoint, pubKeyPoint.G2) {
+		return crypto.ErrSigNotValid
+	}
+
+	return nil
+}
+
+// Unblind removes the blinding factor from the blind signature, which gives the signature of the original message
+func (s *BlsSingleSigner) Unblind(blindSig []byte, blindingFactor *mcl.Scalar) ([]byte, error) {
+	if check.IfNil(blindingFactor) || blindingFactor.Scalar == nil || blindingFactor.Scalar.IsZero() {
+		return nil, crypto.ErrInvalidScalar
+	}
+
+	blindSigPoint, err := blindSigBytesToPoint(blindSig)
+	if err != nil {
+		return nil, err
+	}
+
+	inverse := &bls.Fr{}
+	bls.FrInv(inverse, blindingFactor.Scalar)
+
+	sig := &bls.G1{}
+	bls.G1MulCT(sig, blindSigPoint, inverse)
+
+	return sig.Serialize(), nil
+}
+
+// g1PointFromBytes deserializes a blinded message and checks it is a valid point of G1, other than the identity
+func g1PointFromBytes(data []byte) (*bls.G1, error) {
+	if len(data) == 0 {
+		return nil, crypto.ErrNilMessage
+	}
+
+	point := &bls.G1{}
+	err := point.Deserialize(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if point.IsZero() || !point.IsValidOrder() || !point.IsValid() {
+		return nil, crypto.ErrInvalidPoint
+	}
+
+	return point, nil
+}
+
+func blindSigBytesToPoint(sig []byte) (*bls.G1, error) {
+	if len(sig) == 0 {
+		return nil, crypto.ErrNilSignature
+	}
+
+	point := &bls.G1{}
+	err := point.Deserialize(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsSigValidPoint(bls.CastToSign(point)) {
+		return nil, crypto.ErrBLSInvalidSignature
+	}
+
+	return point, nil
+}
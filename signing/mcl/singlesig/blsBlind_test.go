@@ -0,0 +1,144 @@
+package singlesig_test
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlsSingleSigner_Blind(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	msg := []byte("message to be signed")
+
+	t.Run("nil message should err", func(t *testing.T) {
+		blinded, factor, err := signer.Blind(nil)
+		require.Nil(t, blinded)
+		require.Nil(t, factor)
+		require.Equal(t, crypto.ErrNilMessage, err)
+	})
+	t.Run("blinded messages should be randomized", func(t *testing.T) {
+		blinded1, factor1, err := signer.Blind(msg)
+		require.Nil(t, err)
+		require.NotNil(t, factor1)
+
+		blinded2, factor2, _ := signer.Blind(msg)
+		require.NotEqual(t, blinded1, blinded2)
+
+		eq, _ := factor1.Equal(factor2)
+		require.False(t, eq)
+	})
+}
+
+func TestBlsSingleSigner_SignBlinded(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	privKey, _ := signing.NewKeyGenerator(mcl.NewSuiteBLS12()).GeneratePair()
+	blinded, _, _ := signer.Blind([]byte("message to be signed"))
+
+	t.Run("nil private key should err", func(t *testing.T) {
+		blindSig, err := signer.SignBlinded(nil, blinded)
+		require.Nil(t, blindSig)
+		require.Equal(t, crypto.ErrNilPrivateKey, err)
+	})
+	t.Run("nil blinded message should err", func(t *testing.T) {
+		blindSig, err := signer.SignBlinded(privKey, nil)
+		require.Nil(t, blindSig)
+		require.Equal(t, crypto.ErrNilMessage, err)
+	})
+	t.Run("identity as blinded message should err", func(t *testing.T) {
+		zero := &bls.G1{}
+		zero.Clear()
+
+		blindSig, err := signer.SignBlinded(privKey, zero.Serialize())
+		require.Nil(t, blindSig)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		blindSig, err := signer.SignBlinded(privKey, blinded)
+		require.Nil(t, err)
+		require.Equal(t, bls.GetG1ByteSize(), len(blindSig))
+	})
+}
+
+func TestBlsSingleSigner_VerifyBlinded(t *testing.T) {
+	t.Parallel()
+
+	signer := singlesig.NewBlsSigner()
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privKey, pubKey := kg.GeneratePair()
+	blinded, _, _ := signer.Blind([]byte("message to be signed"))
+	blindSig, _ := signer.SignBlinded(privKey, blinded)
+
+	t.Run("nil public key should err", func(t *testing.T) {
+		err := signer.VerifyBlinded(nil, blinded, blindSig)
+		require.Equal(t, crypto.ErrNilPublicKey, err)
+	})
+	t.Run("nil blind signature should err", func(t *testing.T) {
+		err := signer.VerifyBlinded(pubKey, blinded, nil)
+		require.Equal(t, crypto.ErrNilSignature, err)
+	})
+	t.Run("other public key should err", func(t *testing.T) {
+		_, otherPubKey := kg.GeneratePair()
+		err := signer.VerifyBlinded(otherPubKey, blinded, blindSig)
+		require.Equal(t, crypto.ErrSigNotValid, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		err := signer.VerifyBlinded(pubKey, blinded, blindSig)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsSingleSigner_Unblind(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message to be signed")
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privKey, pubKey := kg.GeneratePair()
+
+	t.Run("invalid blinding factor should err", func(t *testing.T) {
+		signer := singlesig.NewBlsSigner()
+		blinded, _, _ := signer.Blind(msg)
+		blindSig, _ := signer.SignBlinded(privKey, blinded)
+
+		sig, err := signer.Unblind(blindSig, nil)
+		require.Nil(t, sig)
+		require.Equal(t, crypto.ErrInvalidScalar, err)
+
+		sig, err = signer.Unblind(blindSig, mcl.NewScalar().Zero().(*mcl.Scalar))
+		require.Nil(t, sig)
+		require.Equal(t, crypto.ErrInvalidScalar, err)
+	})
+	t.Run("other blinding factor should give an invalid signature", func(t *testing.T) {
+		signer := singlesig.NewBlsSigner()
+		blinded, _, _ := signer.Blind(msg)
+		_, otherFactor, _ := signer.Blind(msg)
+		blindSig, _ := signer.SignBlinded(privKey, blinded)
+
+		sig, err := signer.Unblind(blindSig, otherFactor)
+		require.Nil(t, err)
+		require.Equal(t, crypto.ErrSigNotValid, signer.Verify(pubKey, msg, sig))
+	})
+	t.Run("unblinded signature should be the regular signature", func(t *testing.T) {
+		signerWithDST, _ := singlesig.NewBlsSignerWithDST([]byte(testDST))
+		for _, signer := range []*singlesig.BlsSingleSigner{singlesig.NewBlsSigner(), signerWithDST} {
+			blinded, factor, _ := signer.Blind(msg)
+			blindSig, _ := signer.SignBlinded(privKey, blinded)
+			require.NotEqual(t, blinded, blindSig)
+
+			sig, err := signer.Unblind(blindSig, factor)
+			require.Nil(t, err)
+			require.Nil(t, signer.Verify(pubKey, msg, sig))
+
+			expectedSig, _ := signer.Sign(privKey, msg)
+			require.Equal(t, expectedSig, sig)
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package singlesig
+
+import (
+	"runtime"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+)
+
+var _ crypto.SingleSigner = (*BlsMinPubKeySigner)(nil)
+
+// BlsMinPubKeySigner is a SingleSigner implementation for the "minimal public key" BLS variant, to be used with
+// the keys of mcl.SuiteBLS12MinPubKey: the public keys are points on G1 and the signatures are points on G2.
+// A signature over a message m with the private key sk is sk*H(m), with H hashing to G2, and it is verified by
+// checking that e(pk, H(m)) == e(g1, sig)
+type BlsMinPubKeySigner struct {
+	dst []byte
+}
+
+// NewBlsMinPubKeySigner creates a "minimal public key" BLS single signer instance that maps the messages to G2 with
+// the default mapping of the herumi library
+func NewBlsMinPubKeySigner() *BlsMinPubKeySigner {
+	return &BlsMinPubKeySigner{}
+}
+
+// NewBlsMinPubKeySignerWithDST creates a "minimal public key" BLS single signer instance that hashes the messages to
+// G2 as defined in RFC 9380 (BLS12381G2_XMD:SHA-256_SSWU_RO_), with the given domain separation tag
+func NewBlsMinPubKeySignerWithDST(dst []byte) (*BlsMinPubKeySigner, error) {
+	if len(dst) == 0 || len(dst) > mcl.MaxDSTLen {
+		return nil, crypto.ErrInvalidDomainSeparationTag
+	}
+
+	return &BlsMinPubKeySigner{
+		dst: append([]byte{}, dst...),
+	}, nil
+}
+
+// Sign Signs a message using a single signature BLS scheme
+func (s *BlsMinPubKeySigner) Sign(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	if check.IfNil(private) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if len(msg) == 0 {
+		return nil, crypto.ErrNilMessage
+	}
+
+	scalar := private.Scalar()
+	if check.IfNil(scalar) {
+		return nil, crypto.ErrNilPrivateKeyScalar
+	}
+
+	mclScalar, ok := scalar.(*mcl.Scalar)
+	if !ok || !IsSecretKeyValid(mclScalar) {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	hashPoint, err := s.HashToG2(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := &bls.G2{}
+	mcl.G2MulCT(sig, hashPoint, mclScalar.Scalar)
+	runtime.KeepAlive(mclScalar)
+
+	return sig.Serialize(), nil
+}
+
+// Verify verifies a signature using a single signature BLS scheme
+func (s *BlsMinPubKeySigner) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	if check.IfNil(public) {
+		return crypto.ErrNilPublicKey
+	}
+	if len(msg) == 0 {
+		return crypto.ErrNilMessage
+	}
+	if len(sig) == 0 {
+		return crypto.ErrNilSignature
+	}
+
+	point := public.Point()
+	if check.IfNil(point) {
+		return crypto.ErrNilPublicKeyPoint
+	}
+
+	pubKeyPoint, isPoint := point.(*mcl.PointG1)
+	if !isPoint || !IsMinPubKeyPointValid(pubKeyPoint) {
+		return crypto.ErrInvalidPublicKey
+	}
+
+	sigPoint, err := MinPubKeySigBytesToG2(sig)
+	if err != nil {
+		return err
+	}
+
+	hashPoint, err := s.HashToG2(msg)
+	if err != nil {
+		return err
+	}
+
+	if !IsMinPubKeyPairingValid(sigPoint, hashPoint, pubKeyPoint.G1) {
+		return crypto.ErrSigNotValid
+	}
+
+	return nil
+}
+
+// HashToG2 maps the message to G2 with the hashing configured for the signer
+func (s *BlsMinPubKeySigner) HashToG2(msg []byte) (*bls.G2, error) {
+	if len(s.dst) == 0 {
+		hashPoint := &bls.G2{}
+		err := hashPoint.HashAndMapTo(msg)
+
+		return hashPoint, err
+	}
+
+	return mcl.HashToG2(msg, s.dst)
+}
+
+// MinPubKeySigBytesToG2 deserializes a "minimal public key" signature and checks that it is a valid point on G2
+func MinPubKeySigBytesToG2(sig []byte) (*bls.G2, error) {
+	if len(sig) == 0 {
+		return nil, crypto.ErrNilSignature
+	}
+
+	sigPoint := &bls.G2{}
+	err := sigPoint.Deserialize(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	if sigPoint.IsZero() || !sigPoint.IsValidOrder() || !sigPoint.IsValid() {
+		return nil, crypto.ErrBLSInvalidSignature
+	}
+
+	return sigPoint, nil
+}
+
+// IsMinPubKeyPairingValid checks e(pubKey, hashPoint) == e(g1, sig)
+func IsMinPubKeyPairingValid(sig *bls.G2, hashPoint *bls.G2, pubKey *bls.G1) bool {
+	negGenerator := &bls.G1{}
+	bls.G1Neg(negGenerator, mcl.NewPointG1().G1)
+
+	pointsG1 := []bls.G1{*negGenerator, *pubKey}
+	pointsG2 := []bls.G2{*sig, *hashPoint}
+
+	return mcl.IsPairingProductOne(pointsG1, pointsG2)
+}
+
+// IsMinPubKeyPointValid validates the "minimal public key" public key is a valid point on G1
+func IsMinPubKeyPointValid(pubKeyPoint *mcl.PointG1) bool {
+	return !pubKeyPoint.IsZero() && pubKeyPoint.IsValidOrder() && pubKeyPoint.IsValid()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *BlsMinPubKeySigner) IsInterfaceNil() bool {
+	return s == nil
+}
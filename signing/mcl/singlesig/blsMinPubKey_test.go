@@ -0,0 +1,116 @@
+package singlesig_test
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlsMinPubKeySignerWithDST(t *testing.T) {
+	t.Parallel()
+
+	signer, err := singlesig.NewBlsMinPubKeySignerWithDST(nil)
+	require.Nil(t, signer)
+	require.Equal(t, crypto.ErrInvalidDomainSeparationTag, err)
+
+	signer, err = singlesig.NewBlsMinPubKeySignerWithDST([]byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_"))
+	require.Nil(t, err)
+	require.False(t, check.IfNil(signer))
+}
+
+func TestBlsMinPubKeySigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message to be signed")
+	signer := singlesig.NewBlsMinPubKeySigner()
+	privKey, _ := signing.NewKeyGenerator(mcl.NewSuiteBLS12MinPubKey()).GeneratePair()
+
+	t.Run("nil private key should err", func(t *testing.T) {
+		sig, err := signer.Sign(nil, msg)
+		require.Nil(t, sig)
+		require.Equal(t, crypto.ErrNilPrivateKey, err)
+	})
+	t.Run("nil message should err", func(t *testing.T) {
+		sig, err := signer.Sign(privKey, nil)
+		require.Nil(t, sig)
+		require.Equal(t, crypto.ErrNilMessage, err)
+	})
+	t.Run("signature should be on G2", func(t *testing.T) {
+		sig, err := signer.Sign(privKey, msg)
+		require.Nil(t, err)
+		require.Equal(t, bls.GetG2ByteSize(), len(sig))
+	})
+}
+
+func TestBlsMinPubKeySigner_Verify(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message to be signed")
+	signer := singlesig.NewBlsMinPubKeySigner()
+	privKey, pubKey := signing.NewKeyGenerator(mcl.NewSuiteBLS12MinPubKey()).GeneratePair()
+	sig, _ := signer.Sign(privKey, msg)
+
+	t.Run("nil public key should err", func(t *testing.T) {
+		err := signer.Verify(nil, msg, sig)
+		require.Equal(t, crypto.ErrNilPublicKey, err)
+	})
+	t.Run("nil signature should err", func(t *testing.T) {
+		err := signer.Verify(pubKey, msg, nil)
+		require.Equal(t, crypto.ErrNilSignature, err)
+	})
+	t.Run("public key on G2 should err", func(t *testing.T) {
+		_, pubKeyG2 := signing.NewKeyGenerator(mcl.NewSuiteBLS12()).GeneratePair()
+		err := signer.Verify(pubKeyG2, msg, sig)
+		require.Equal(t, crypto.ErrInvalidPublicKey, err)
+	})
+	t.Run("signature on G1 should err", func(t *testing.T) {
+		sigG1, _ := singlesig.NewBlsSigner().Sign(privKey, msg)
+		err := signer.Verify(pubKey, msg, sigG1)
+		require.NotNil(t, err)
+	})
+	t.Run("other message should err", func(t *testing.T) {
+		err := signer.Verify(pubKey, []byte("other message"), sig)
+		require.Equal(t, crypto.ErrSigNotValid, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		err := signer.Verify(pubKey, msg, sig)
+		require.Nil(t, err)
+	})
+}
+
+func TestBlsMinPubKeySigner_SignVerifyWithDST(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message to be signed")
+	dst := []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+	signer, _ := singlesig.NewBlsMinPubKeySignerWithDST(dst)
+	privKey, pubKey := signing.NewKeyGenerator(mcl.NewSuiteBLS12MinPubKey()).GeneratePair()
+
+	sig, err := signer.Sign(privKey, msg)
+	require.Nil(t, err)
+	require.Nil(t, signer.Verify(pubKey, msg, sig))
+
+	hashPoint, _ := mcl.HashToG2(msg, dst)
+	expected := &bls.G2{}
+	bls.G2Mul(expected, hashPoint, privKey.Scalar().(*mcl.Scalar).Scalar)
+	require.Equal(t, expected.Serialize(), sig)
+
+	err = singlesig.NewBlsMinPubKeySigner().Verify(pubKey, msg, sig)
+	require.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestBlsMinPubKeySigner_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var signer *singlesig.BlsMinPubKeySigner
+	require.True(t, check.IfNil(signer))
+
+	signer = singlesig.NewBlsMinPubKeySigner()
+	require.False(t, check.IfNil(signer))
+}
@@ -60,3 +60,36 @@ func BenchmarkBlsSingleSigner_Verify(b *testing.B) {
 		require.Nil(b, err)
 	}
 }
+
+func BenchmarkBlsSingleSigner_VerifyWithPairingLines(b *testing.B) {
+	signer := singlesig.NewBlsSigner()
+	signer.PairingLines, _ = mcl.NewPairingLinesCache(1)
+	suite := mcl.NewSuiteBLS12()
+	kg := signing.NewKeyGenerator(suite)
+	privKey, pubKey := kg.GeneratePair()
+	err := signer.PairingLines.Register(pubKey.Point().(*mcl.PointG2))
+	require.Nil(b, err)
+
+	msg := []byte("message to be signed")
+	signature, err := signer.Sign(privKey, msg)
+	require.Nil(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err = signer.Verify(pubKey, msg, signature)
+		require.Nil(b, err)
+	}
+}
+
+func BenchmarkBlsSingleSigner_BatchVerify(b *testing.B) {
+	signer := singlesig.NewBlsSigner()
+	nbEntries := 100
+	pubKeys, messages, signatures := createBatch(b, nbEntries)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		invalid, err := signer.BatchVerify(pubKeys, messages, signatures)
+		require.Nil(b, err)
+		require.Nil(b, invalid)
+	}
+}
@@ -3,6 +3,7 @@ package singlesig_test
 import (
 	"testing"
 
+	"github.com/herumi/bls-go-binary/bls"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/mock"
@@ -73,6 +74,8 @@ func TestBLSSigner_SignInvalidScalarShouldErr(t *testing.T) {
 	require.Equal(t, crypto.ErrInvalidPrivateKey, err)
 }
 
+const testDST = "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_"
+
 func signBLS(msg []byte, signer crypto.SingleSigner, t *testing.T) (
 	pubKey crypto.PublicKey,
 	privKey crypto.PrivateKey,
@@ -183,6 +186,38 @@ func TestBLSSigner_VerifyInvalidPublicKeyShouldErr(t *testing.T) {
 	require.Equal(t, crypto.ErrInvalidPublicKey, err)
 }
 
+type validatedPublicKeyStub struct {
+	mock.PublicKeyStub
+	validated bool
+}
+
+func (pubKey *validatedPublicKeyStub) IsValidated() bool {
+	return pubKey.validated
+}
+
+func TestIsPubKeyValid(t *testing.T) {
+	t.Parallel()
+
+	zeroPoint := mcl.NewPointG2().Null().(*mcl.PointG2)
+	pubKeyWithPoint := func(point crypto.Point) mock.PublicKeyStub {
+		return mock.PublicKeyStub{
+			PointStub: func() crypto.Point {
+				return point
+			},
+		}
+	}
+
+	pubKey := pubKeyWithPoint(zeroPoint)
+	require.False(t, singlesig.IsPubKeyValid(&pubKey, zeroPoint))
+	require.False(t, singlesig.IsPubKeyValid(&validatedPublicKeyStub{PublicKeyStub: pubKey}, zeroPoint))
+	// only the public keys validated by the key generators are trusted, not the other implementations
+	require.False(t, singlesig.IsPubKeyValid(&validatedPublicKeyStub{PublicKeyStub: pubKey, validated: true}, zeroPoint))
+
+	validPoint, _ := mcl.NewPointG2().Pick()
+	pubKey = pubKeyWithPoint(validPoint)
+	require.True(t, singlesig.IsPubKeyValid(&pubKey, validPoint.(*mcl.PointG2)))
+}
+
 func TestBLSSigner_VerifyOK(t *testing.T) {
 	t.Parallel()
 
@@ -235,6 +270,96 @@ func TestBLSSigner_VerifyInvalidSignatureShouldErr(t *testing.T) {
 	require.Equal(t, crypto.ErrSigNotValid, err)
 }
 
+func TestNewBlsSignerWithDST(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty dst should err", func(t *testing.T) {
+		signer, err := singlesig.NewBlsSignerWithDST(nil)
+		require.Nil(t, signer)
+		require.Equal(t, crypto.ErrInvalidDomainSeparationTag, err)
+	})
+	t.Run("too long dst should err", func(t *testing.T) {
+		signer, err := singlesig.NewBlsSignerWithDST(make([]byte, mcl.MaxDSTLen+1))
+		require.Nil(t, signer)
+		require.Equal(t, crypto.ErrInvalidDomainSeparationTag, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		signer, err := singlesig.NewBlsSignerWithDST([]byte(testDST))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(signer))
+	})
+}
+
+func TestBLSSigner_SignVerifyWithDST(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message to be signed")
+
+	t.Run("should work", func(t *testing.T) {
+		signer, _ := singlesig.NewBlsSignerWithDST([]byte(testDST))
+		pubKey, _, signature, err := signBLS(msg, signer, t)
+		require.Nil(t, err)
+
+		err = signer.Verify(pubKey, msg, signature)
+		require.Nil(t, err)
+
+		err = signer.Verify(pubKey, []byte("other message"), signature)
+		require.Equal(t, crypto.ErrSigNotValid, err)
+	})
+	t.Run("signature should be sk * hash_to_curve(msg)", func(t *testing.T) {
+		signer, _ := singlesig.NewBlsSignerWithDST([]byte(testDST))
+		_, privKey, signature, err := signBLS(msg, signer, t)
+		require.Nil(t, err)
+
+		hashPoint, err := mcl.HashToG1(msg, []byte(testDST))
+		require.Nil(t, err)
+
+		expected := &bls.G1{}
+		bls.G1Mul(expected, hashPoint, privKey.Scalar().(*mcl.Scalar).Scalar)
+		require.Equal(t, expected.Serialize(), signature)
+	})
+	t.Run("signatures with other domains should not verify", func(t *testing.T) {
+		signer, _ := singlesig.NewBlsSignerWithDST([]byte(testDST))
+		otherSigner, _ := singlesig.NewBlsSignerWithDST([]byte("OTHER_DOMAIN_BLS12381G1_XMD:SHA-256_SSWU_RO_"))
+		defaultSigner := singlesig.NewBlsSigner()
+
+		pubKey, _, signature, err := signBLS(msg, signer, t)
+		require.Nil(t, err)
+		require.Equal(t, crypto.ErrSigNotValid, otherSigner.Verify(pubKey, msg, signature))
+		require.Equal(t, crypto.ErrSigNotValid, defaultSigner.Verify(pubKey, msg, signature))
+
+		pubKey, _, signature, err = signBLS(msg, defaultSigner, t)
+		require.Nil(t, err)
+		require.Equal(t, crypto.ErrSigNotValid, signer.Verify(pubKey, msg, signature))
+	})
+}
+
+func TestBLSSigner_VerifyWithPairingLines(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message to be signed")
+	signerWithDST, _ := singlesig.NewBlsSignerWithDST([]byte(testDST))
+
+	for _, signer := range []*singlesig.BlsSingleSigner{singlesig.NewBlsSigner(), signerWithDST} {
+		pubKey, _, signature, err := signBLS(msg, signer, t)
+		require.Nil(t, err)
+		otherPubKey, _, _, err := signBLS(msg, signer, t)
+		require.Nil(t, err)
+
+		signer.PairingLines, _ = mcl.NewPairingLinesCache(10)
+		for _, registered := range []bool{false, true} {
+			if registered {
+				require.Nil(t, signer.PairingLines.Register(pubKey.Point().(*mcl.PointG2)))
+				require.Nil(t, signer.PairingLines.Register(otherPubKey.Point().(*mcl.PointG2)))
+			}
+
+			require.Nil(t, signer.Verify(pubKey, msg, signature))
+			require.Equal(t, crypto.ErrSigNotValid, signer.Verify(pubKey, []byte("other message"), signature))
+			require.Equal(t, crypto.ErrSigNotValid, signer.Verify(otherPubKey, msg, signature))
+		}
+	}
+}
+
 func TestBLSSigner_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -31,6 +31,11 @@ type SuiteBLS12 struct {
 // For MultiversX the public keys for the validators are known during an epoch and also are not set on blocks
 // BLS signatures are however set on every block header, so in order to optimise the header size flag will be false
 // to have smaller signatures, so on G1(48 bytes)
+// The opposite layout is also available at runtime, without the flag, through SuiteBLS12MinPubKey
+
+// standardG2Str is the generator of G2 defined by the BLS12-381 specification and used by the IETF drafts, ZCash
+// and Ethereum. The generator of the public keys of SuiteBLS12 is the default one of the herumi library, which differs
+const standardG2Str = "1 352701069587466618187139116011060144890029952792775240219908644239793785735715026873347600343865175952761926303160 3059144344244213709971259814753781636986470325476647558659373206291635324768958432433509563104347017837885763365758 1985150602287291935568054521177171638300868978215655730859378665066344726373823718423869104263333984641494340347905 927553665492332455747201965776037880757740193453592970025027978793976877002675564980949289727957565575433344219582"
 
 var (
 	g2str  = "1 352701069587466618187139116011060144890029952792775240219908644239793785735715026873347600343865175952761926303160 3059144344244213709971259814753781636986470325476647558659373206291635324768958432433509563104347017837885763365758 1985150602287291935568054521177171638300868978215655730859378665066344726373823718423869104263333984641494340347905 927553665492332455747201965776037880757740193453592970025027978793976877002675564980949289727957565575433344219582"
@@ -171,3 +176,14 @@ func baseG1() string {
 func baseG2() string {
 	return g2str
 }
+
+// NewStandardGeneratorG2 returns the generator of G2 defined by the BLS12-381 specification
+func NewStandardGeneratorG2() *bls.G2 {
+	generator := &bls.G2{}
+	err := generator.SetString(standardG2Str, 10)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return generator
+}
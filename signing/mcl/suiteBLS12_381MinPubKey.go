@@ -0,0 +1,132 @@
+package mcl
+
+import (
+	"crypto/cipher"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+var _ crypto.Group = (*SuiteBLS12MinPubKey)(nil)
+var _ crypto.Random = (*SuiteBLS12MinPubKey)(nil)
+var _ crypto.Suite = (*SuiteBLS12MinPubKey)(nil)
+
+// SuiteBLS12MinPubKey provides an implementation of the Suite interface for the "minimal public key" variant of
+// BLS12-381, with the public keys on G1 (48 bytes) and the signatures on G2 (96 bytes). It gives at runtime the same
+// layout that the BLS_SWAP_G build flag gives for SuiteBLS12, so both layouts can be used from the same binary
+type SuiteBLS12MinPubKey struct {
+	G1       *groupG1
+	G2       *groupG2
+	GT       *groupGT
+	strSuite string
+}
+
+// NewSuiteBLS12MinPubKey returns a wrapper over a BLS12 curve, with the public keys on G1
+func NewSuiteBLS12MinPubKey() *SuiteBLS12MinPubKey {
+	return &SuiteBLS12MinPubKey{
+		G1:       &groupG1{},
+		G2:       &groupG2{},
+		GT:       &groupGT{},
+		strSuite: "BLS12-381 minimal public key suite",
+	}
+}
+
+// RandomStream returns a cipher.Stream that returns a key stream
+// from crypto/rand.
+func (s *SuiteBLS12MinPubKey) RandomStream() cipher.Stream {
+	// random stream is internal in mcl library so not needed
+	return nil
+}
+
+// CreatePoint creates a new point
+func (s *SuiteBLS12MinPubKey) CreatePoint() crypto.Point {
+	return s.G1.CreatePoint()
+}
+
+// String returns the string for the group
+func (s *SuiteBLS12MinPubKey) String() string {
+	return s.strSuite
+}
+
+// ScalarLen returns the maximum length of scalars in bytes
+func (s *SuiteBLS12MinPubKey) ScalarLen() int {
+	return s.G1.ScalarLen()
+}
+
+// CreateScalar creates a new Scalar
+func (s *SuiteBLS12MinPubKey) CreateScalar() crypto.Scalar {
+	return s.G1.CreateScalar()
+}
+
+// CreatePointForScalar creates a new point on G1 corresponding to the given scalar
+func (s *SuiteBLS12MinPubKey) CreatePointForScalar(scalar crypto.Scalar) (crypto.Point, error) {
+	if check.IfNil(scalar) {
+		return nil, crypto.ErrNilPrivateKeyScalar
+	}
+	sc, ok := scalar.GetUnderlyingObj().(*bls.Fr)
+	if !ok {
+		return nil, crypto.ErrInvalidScalar
+	}
+
+	if sc.IsZero() || !sc.IsValid() {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	point := s.G1.CreatePointForScalar(scalar)
+
+	return point, nil
+}
+
+// PointLen returns the max length of point in nb of bytes
+func (s *SuiteBLS12MinPubKey) PointLen() int {
+	return s.G1.PointLen()
+}
+
+// CreateKeyPair returns a pair of private public BLS keys.
+// The private key is a scalarInt, while the public key is a Point on G1 curve
+func (s *SuiteBLS12MinPubKey) CreateKeyPair() (crypto.Scalar, crypto.Point) {
+	var sc crypto.Scalar
+	var err error
+
+	sc = s.G1.CreateScalar()
+	sc, err = sc.Pick()
+	if err != nil {
+		log.Error("SuiteBLS12MinPubKey CreateKeyPair", "error", err.Error())
+		return nil, nil
+	}
+
+	p := s.G1.CreatePointForScalar(sc)
+
+	return sc, p
+}
+
+// GetUnderlyingSuite returns the underlying suite
+func (s *SuiteBLS12MinPubKey) GetUnderlyingSuite() interface{} {
+	return s
+}
+
+// CheckPointValid returns error if the point is not valid (zero is also not valid), otherwise nil
+func (s *SuiteBLS12MinPubKey) CheckPointValid(pointBytes []byte) error {
+	if len(pointBytes) != s.PointLen() {
+		return crypto.ErrInvalidParam
+	}
+
+	point := s.G1.CreatePoint()
+	err := point.UnmarshalBinary(pointBytes)
+	if err != nil {
+		return err
+	}
+
+	pG1, ok := point.GetUnderlyingObj().(*bls.G1)
+	if !ok || !pG1.IsValid() || !pG1.IsValidOrder() || pG1.IsZero() {
+		return crypto.ErrInvalidPoint
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *SuiteBLS12MinPubKey) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,88 @@
+package mcl
+
+import (
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSuiteBLS12MinPubKey(t *testing.T) {
+	suite := NewSuiteBLS12MinPubKey()
+
+	assert.False(t, check.IfNil(suite))
+	assert.Nil(t, suite.RandomStream())
+	assert.Equal(t, "BLS12-381 minimal public key suite", suite.String())
+	assert.Equal(t, 32, suite.ScalarLen())
+	assert.Equal(t, 48, suite.PointLen())
+	assert.Equal(t, suite, suite.GetUnderlyingSuite())
+}
+
+func TestSuiteBLS12MinPubKey_CreatePoint(t *testing.T) {
+	suite := NewSuiteBLS12MinPubKey()
+
+	point := suite.CreatePoint()
+	_, ok := point.(*PointG1)
+	require.True(t, ok)
+}
+
+func TestSuiteBLS12MinPubKey_CreatePointForScalar(t *testing.T) {
+	suite := NewSuiteBLS12MinPubKey()
+
+	t.Run("nil scalar should err", func(t *testing.T) {
+		point, err := suite.CreatePointForScalar(nil)
+		require.Equal(t, crypto.ErrNilPrivateKeyScalar, err)
+		require.Nil(t, point)
+	})
+	t.Run("zero scalar should err", func(t *testing.T) {
+		point, err := suite.CreatePointForScalar(NewScalar().Zero())
+		require.Equal(t, crypto.ErrInvalidPrivateKey, err)
+		require.Nil(t, point)
+	})
+	t.Run("should work", func(t *testing.T) {
+		scalar, _ := NewScalar().Pick()
+		point, err := suite.CreatePointForScalar(scalar)
+		require.Nil(t, err)
+
+		expected := &bls.G1{}
+		bls.G1Mul(expected, NewPointG1().G1, scalar.(*Scalar).Scalar)
+		require.True(t, point.(*PointG1).IsEqual(expected))
+	})
+}
+
+func TestSuiteBLS12MinPubKey_CreateKeyPair(t *testing.T) {
+	suite := NewSuiteBLS12MinPubKey()
+
+	scalar, point := suite.CreateKeyPair()
+
+	pG1, ok := point.GetUnderlyingObj().(*bls.G1)
+	require.True(t, ok)
+
+	expected, err := suite.CreatePointForScalar(scalar)
+	require.Nil(t, err)
+	require.True(t, pG1.IsEqual(expected.(*PointG1).G1))
+}
+
+func TestSuiteBLS12MinPubKey_CheckPointValid(t *testing.T) {
+	suite := NewSuiteBLS12MinPubKey()
+
+	t.Run("wrong length should err", func(t *testing.T) {
+		err := suite.CheckPointValid(make([]byte, 96))
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("zero point should err", func(t *testing.T) {
+		zero := &bls.G1{}
+		zero.Clear()
+		err := suite.CheckPointValid(zero.Serialize())
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("valid point should work", func(t *testing.T) {
+		_, point := suite.CreateKeyPair()
+		pointBytes, _ := point.MarshalBinary()
+		err := suite.CheckPointValid(pointBytes)
+		require.Nil(t, err)
+	})
+}
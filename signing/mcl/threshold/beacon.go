@@ -0,0 +1,189 @@
+package threshold
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+const roundBytesLen = 8
+
+// BeaconEntry is the output of one round of the randomness beacon
+type BeaconEntry struct {
+	Round              uint64
+	PreviousRandomness []byte
+	Signature          []byte
+	Randomness         []byte
+}
+
+// ArgsRandomnessBeacon holds the arguments needed to create a randomness beacon
+type ArgsRandomnessBeacon struct {
+	// ThresholdSigner is the threshold signer of the key shared by the beacon committee
+	ThresholdSigner *BlsThresholdSigner
+	// GroupPublicKey is the public key of the shared key, the entries are verified against it
+	GroupPublicKey crypto.PublicKey
+	// Hasher hashes the round messages and the group signatures into the beacon values
+	Hasher hashing.Hasher
+	// GenesisSeed is the randomness of the genesis entry, the round 0 of the chain
+	GenesisSeed []byte
+}
+
+// RandomnessBeacon produces a chain of unbiasable random values with a threshold shared BLS key. In every round,
+// the share holders sign the message H(round || previous randomness), any threshold partial signatures are combined
+// into the group signature and the beacon value is H(group signature). BLS signatures are unique, so the beacon value
+// does not depend on which shares were combined and no coalition smaller than the threshold can predict or bias it.
+type RandomnessBeacon struct {
+	thresholdSigner *BlsThresholdSigner
+	groupPublicKey  crypto.PublicKey
+	hasher          hashing.Hasher
+	genesisSeed     []byte
+}
+
+// NewRandomnessBeacon creates a randomness beacon
+func NewRandomnessBeacon(args ArgsRandomnessBeacon) (*RandomnessBeacon, error) {
+	if check.IfNil(args.ThresholdSigner) {
+		return nil, crypto.ErrNilThresholdSigner
+	}
+	if check.IfNil(args.GroupPublicKey) {
+		return nil, crypto.ErrNilPublicKey
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, crypto.ErrNilHasher
+	}
+	if len(args.GenesisSeed) == 0 {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	return &RandomnessBeacon{
+		thresholdSigner: args.ThresholdSigner,
+		groupPublicKey:  args.GroupPublicKey,
+		hasher:          args.Hasher,
+		genesisSeed:     append([]byte{}, args.GenesisSeed...),
+	}, nil
+}
+
+// GenesisEntry returns the entry of the round 0, which holds the genesis seed and is not signed
+func (rb *RandomnessBeacon) GenesisEntry() *BeaconEntry {
+	return &BeaconEntry{
+		Round:      0,
+		Randomness: append([]byte{}, rb.genesisSeed...),
+	}
+}
+
+// RoundMessage returns the message signed in the round that follows the previous entry
+func (rb *RandomnessBeacon) RoundMessage(previous *BeaconEntry) ([]byte, error) {
+	if previous == nil {
+		return nil, crypto.ErrNilBeaconEntry
+	}
+	if len(previous.Randomness) == 0 {
+		return nil, crypto.ErrInvalidBeaconEntry
+	}
+
+	return rb.roundMessage(previous.Round+1, previous.Randomness), nil
+}
+
+func (rb *RandomnessBeacon) roundMessage(round uint64, previousRandomness []byte) []byte {
+	message := make([]byte, roundBytesLen, roundBytesLen+len(previousRandomness))
+	binary.BigEndian.PutUint64(message, round)
+	message = append(message, previousRandomness...)
+
+	return rb.hasher.Compute(string(message))
+}
+
+// SignRoundShare creates the partial signature of the key share for the round that follows the previous entry
+func (rb *RandomnessBeacon) SignRoundShare(share *KeyShare, previous *BeaconEntry) (*SignatureShare, error) {
+	message, err := rb.RoundMessage(previous)
+	if err != nil {
+		return nil, err
+	}
+
+	return rb.thresholdSigner.SignShare(share, message)
+}
+
+// VerifyRoundShare verifies the partial signature for the round that follows the previous entry against the public
+// key of the key share
+func (rb *RandomnessBeacon) VerifyRoundShare(sharePubKey crypto.PublicKey, previous *BeaconEntry, sigShare *SignatureShare) error {
+	message, err := rb.RoundMessage(previous)
+	if err != nil {
+		return err
+	}
+
+	return rb.thresholdSigner.VerifySignatureShare(sharePubKey, message, sigShare)
+}
+
+// ProduceEntry combines the partial signatures of the round that follows the previous entry into the next entry.
+// The recovered group signature is verified, so an invalid partial signature among the first threshold ones makes
+// the production fail, and the caller should retry with verified shares
+func (rb *RandomnessBeacon) ProduceEntry(previous *BeaconEntry, sigShares []*SignatureShare) (*BeaconEntry, error) {
+	message, err := rb.RoundMessage(previous)
+	if err != nil {
+		return nil, err
+	}
+
+	groupSig, err := rb.thresholdSigner.RecoverSignature(sigShares)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rb.thresholdSigner.singleSigner.Verify(rb.groupPublicKey, message, groupSig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BeaconEntry{
+		Round:              previous.Round + 1,
+		PreviousRandomness: append([]byte{}, previous.Randomness...),
+		Signature:          groupSig,
+		Randomness:         rb.hasher.Compute(string(groupSig)),
+	}, nil
+}
+
+// VerifyEntry verifies that the entry is the valid successor of the previous entry
+func (rb *RandomnessBeacon) VerifyEntry(previous *BeaconEntry, entry *BeaconEntry) error {
+	if previous == nil || entry == nil {
+		return crypto.ErrNilBeaconEntry
+	}
+	if entry.Round != previous.Round+1 || !bytes.Equal(entry.PreviousRandomness, previous.Randomness) {
+		return crypto.ErrInvalidBeaconEntry
+	}
+
+	message, err := rb.RoundMessage(previous)
+	if err != nil {
+		return err
+	}
+
+	err = rb.thresholdSigner.singleSigner.Verify(rb.groupPublicKey, message, entry.Signature)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(entry.Randomness, rb.hasher.Compute(string(entry.Signature))) {
+		return crypto.ErrInvalidBeaconEntry
+	}
+
+	return nil
+}
+
+// VerifyChain verifies that the entries are consecutive successors of the trusted entry, which can be the genesis
+// entry or any entry verified beforehand
+func (rb *RandomnessBeacon) VerifyChain(trusted *BeaconEntry, entries []*BeaconEntry) error {
+	previous := trusted
+	for _, entry := range entries {
+		err := rb.VerifyEntry(previous, entry)
+		if err != nil {
+			return err
+		}
+
+		previous = entry
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rb *RandomnessBeacon) IsInterfaceNil() bool {
+	return rb == nil
+}
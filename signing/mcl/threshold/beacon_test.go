@@ -0,0 +1,224 @@
+package threshold_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/mock"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/threshold"
+	"github.com/stretchr/testify/require"
+)
+
+func createBeacon(t *testing.T, thresh uint32, numShares uint32) (*threshold.RandomnessBeacon, []*threshold.KeyShare) {
+	thresholdSigner, _, groupPubKey, shares := createKeyShares(t, thresh, numShares)
+
+	beacon, err := threshold.NewRandomnessBeacon(threshold.ArgsRandomnessBeacon{
+		ThresholdSigner: thresholdSigner,
+		GroupPublicKey:  groupPubKey,
+		Hasher:          &mock.HasherMock{},
+		GenesisSeed:     []byte("genesis seed"),
+	})
+	require.Nil(t, err)
+
+	return beacon, shares
+}
+
+func produceEntry(t *testing.T, beacon *threshold.RandomnessBeacon, shares []*threshold.KeyShare, indexes []int, previous *threshold.BeaconEntry) *threshold.BeaconEntry {
+	sigShares := make([]*threshold.SignatureShare, 0, len(indexes))
+	for _, idx := range indexes {
+		sigShare, err := beacon.SignRoundShare(shares[idx], previous)
+		require.Nil(t, err)
+
+		sigShares = append(sigShares, sigShare)
+	}
+
+	entry, err := beacon.ProduceEntry(previous, sigShares)
+	require.Nil(t, err)
+
+	return entry
+}
+
+func TestNewRandomnessBeacon(t *testing.T) {
+	t.Parallel()
+
+	thresholdSigner, _, groupPubKey, _ := createKeyShares(t, 2, 3)
+	createArgs := func() threshold.ArgsRandomnessBeacon {
+		return threshold.ArgsRandomnessBeacon{
+			ThresholdSigner: thresholdSigner,
+			GroupPublicKey:  groupPubKey,
+			Hasher:          &mock.HasherMock{},
+			GenesisSeed:     []byte("genesis seed"),
+		}
+	}
+
+	t.Run("nil threshold signer should err", func(t *testing.T) {
+		args := createArgs()
+		args.ThresholdSigner = nil
+		beacon, err := threshold.NewRandomnessBeacon(args)
+		require.Equal(t, crypto.ErrNilThresholdSigner, err)
+		require.True(t, check.IfNil(beacon))
+	})
+	t.Run("nil group public key should err", func(t *testing.T) {
+		args := createArgs()
+		args.GroupPublicKey = nil
+		beacon, err := threshold.NewRandomnessBeacon(args)
+		require.Equal(t, crypto.ErrNilPublicKey, err)
+		require.True(t, check.IfNil(beacon))
+	})
+	t.Run("nil hasher should err", func(t *testing.T) {
+		args := createArgs()
+		args.Hasher = nil
+		beacon, err := threshold.NewRandomnessBeacon(args)
+		require.Equal(t, crypto.ErrNilHasher, err)
+		require.True(t, check.IfNil(beacon))
+	})
+	t.Run("empty genesis seed should err", func(t *testing.T) {
+		args := createArgs()
+		args.GenesisSeed = nil
+		beacon, err := threshold.NewRandomnessBeacon(args)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+		require.True(t, check.IfNil(beacon))
+	})
+	t.Run("should work", func(t *testing.T) {
+		beacon, err := threshold.NewRandomnessBeacon(createArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(beacon))
+
+		genesis := beacon.GenesisEntry()
+		require.Equal(t, uint64(0), genesis.Round)
+		require.Equal(t, []byte("genesis seed"), genesis.Randomness)
+	})
+}
+
+func TestRandomnessBeacon_RoundMessage(t *testing.T) {
+	t.Parallel()
+
+	beacon, _ := createBeacon(t, 2, 3)
+	genesis := beacon.GenesisEntry()
+
+	msg, err := beacon.RoundMessage(nil)
+	require.Nil(t, msg)
+	require.Equal(t, crypto.ErrNilBeaconEntry, err)
+
+	msg, err = beacon.RoundMessage(&threshold.BeaconEntry{Round: 1})
+	require.Nil(t, msg)
+	require.Equal(t, crypto.ErrInvalidBeaconEntry, err)
+
+	msg, err = beacon.RoundMessage(genesis)
+	require.Nil(t, err)
+
+	otherRound := &threshold.BeaconEntry{Round: 1, Randomness: genesis.Randomness}
+	otherMsg, _ := beacon.RoundMessage(otherRound)
+	require.NotEqual(t, msg, otherMsg)
+}
+
+func TestRandomnessBeacon_SignVerifyRoundShare(t *testing.T) {
+	t.Parallel()
+
+	beacon, shares := createBeacon(t, 2, 3)
+	genesis := beacon.GenesisEntry()
+
+	sigShare, err := beacon.SignRoundShare(shares[0], genesis)
+	require.Nil(t, err)
+	require.Nil(t, beacon.VerifyRoundShare(shares[0].PublicKey, genesis, sigShare))
+
+	err = beacon.VerifyRoundShare(shares[1].PublicKey, genesis, sigShare)
+	require.Equal(t, crypto.ErrSigNotValid, err)
+
+	_, err = beacon.SignRoundShare(shares[0], nil)
+	require.Equal(t, crypto.ErrNilBeaconEntry, err)
+}
+
+func TestRandomnessBeacon_ProduceEntry(t *testing.T) {
+	t.Parallel()
+
+	beacon, shares := createBeacon(t, 3, 5)
+	genesis := beacon.GenesisEntry()
+
+	t.Run("not enough shares should err", func(t *testing.T) {
+		sigShare, _ := beacon.SignRoundShare(shares[0], genesis)
+		entry, err := beacon.ProduceEntry(genesis, []*threshold.SignatureShare{sigShare})
+		require.Nil(t, entry)
+		require.Equal(t, crypto.ErrNotEnoughShares, err)
+	})
+	t.Run("shares of another round should err", func(t *testing.T) {
+		otherPrevious := &threshold.BeaconEntry{Round: 7, Randomness: genesis.Randomness}
+		sigShares := make([]*threshold.SignatureShare, 0, 3)
+		for _, share := range shares[:3] {
+			sigShare, _ := beacon.SignRoundShare(share, otherPrevious)
+			sigShares = append(sigShares, sigShare)
+		}
+
+		entry, err := beacon.ProduceEntry(genesis, sigShares)
+		require.Nil(t, entry)
+		require.Equal(t, crypto.ErrSigNotValid, err)
+	})
+	t.Run("entry should not depend on the combined shares", func(t *testing.T) {
+		entry1 := produceEntry(t, beacon, shares, []int{0, 1, 2}, genesis)
+		entry2 := produceEntry(t, beacon, shares, []int{4, 2, 3}, genesis)
+
+		require.Equal(t, uint64(1), entry1.Round)
+		require.Equal(t, genesis.Randomness, entry1.PreviousRandomness)
+		require.Equal(t, entry1, entry2)
+		require.Nil(t, beacon.VerifyEntry(genesis, entry1))
+	})
+}
+
+func TestRandomnessBeacon_VerifyEntry(t *testing.T) {
+	t.Parallel()
+
+	beacon, shares := createBeacon(t, 2, 3)
+	genesis := beacon.GenesisEntry()
+	entry := produceEntry(t, beacon, shares, []int{0, 1}, genesis)
+
+	t.Run("nil entries should err", func(t *testing.T) {
+		require.Equal(t, crypto.ErrNilBeaconEntry, beacon.VerifyEntry(nil, entry))
+		require.Equal(t, crypto.ErrNilBeaconEntry, beacon.VerifyEntry(genesis, nil))
+	})
+	t.Run("wrong round should err", func(t *testing.T) {
+		modified := *entry
+		modified.Round = 2
+		require.Equal(t, crypto.ErrInvalidBeaconEntry, beacon.VerifyEntry(genesis, &modified))
+	})
+	t.Run("wrong previous randomness should err", func(t *testing.T) {
+		modified := *entry
+		modified.PreviousRandomness = []byte("other randomness")
+		require.Equal(t, crypto.ErrInvalidBeaconEntry, beacon.VerifyEntry(genesis, &modified))
+	})
+	t.Run("wrong randomness should err", func(t *testing.T) {
+		modified := *entry
+		modified.Randomness = []byte("other randomness")
+		require.Equal(t, crypto.ErrInvalidBeaconEntry, beacon.VerifyEntry(genesis, &modified))
+	})
+	t.Run("signature of another group should err", func(t *testing.T) {
+		otherBeacon, otherShares := createBeacon(t, 2, 3)
+		otherEntry := produceEntry(t, otherBeacon, otherShares, []int{0, 1}, genesis)
+		require.Equal(t, crypto.ErrSigNotValid, beacon.VerifyEntry(genesis, otherEntry))
+	})
+	t.Run("should work", func(t *testing.T) {
+		require.Nil(t, beacon.VerifyEntry(genesis, entry))
+	})
+}
+
+func TestRandomnessBeacon_VerifyChain(t *testing.T) {
+	t.Parallel()
+
+	beacon, shares := createBeacon(t, 2, 3)
+	genesis := beacon.GenesisEntry()
+
+	entries := make([]*threshold.BeaconEntry, 0, 5)
+	previous := genesis
+	for i := 0; i < 5; i++ {
+		entry := produceEntry(t, beacon, shares, []int{i % 3, (i + 1) % 3}, previous)
+		entries = append(entries, entry)
+		previous = entry
+	}
+
+	require.Nil(t, beacon.VerifyChain(genesis, entries))
+	require.Nil(t, beacon.VerifyChain(entries[1], entries[2:]))
+	require.Equal(t, crypto.ErrInvalidBeaconEntry, beacon.VerifyChain(genesis, entries[1:]))
+
+	swapped := []*threshold.BeaconEntry{entries[0], entries[2], entries[1]}
+	require.Equal(t, crypto.ErrInvalidBeaconEntry, beacon.VerifyChain(genesis, swapped))
+}
@@ -0,0 +1,234 @@
+package threshold
+
+import (
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+// KeyShare is the share of a group private key held by the participant with the given index
+type KeyShare struct {
+	Index      uint32
+	PrivateKey crypto.PrivateKey
+	PublicKey  crypto.PublicKey
+}
+
+// SignatureShare is a partial signature created with the key share that has the given index
+type SignatureShare struct {
+	Index     uint32
+	Signature []byte
+}
+
+// BlsThresholdSigner provides t-of-n threshold BLS signatures. The group private key is split by a dealer into
+// n Shamir shares, with indexes from 1 to n, and any t partial signatures over the same message can be combined
+// into the BLS signature of the group private key. The recovered signature is a regular BLS signature, so it can be
+// verified by the BlsSingleSigner against the group public key.
+type BlsThresholdSigner struct {
+	threshold    uint32
+	numShares    uint32
+	keyGen       crypto.KeyGenerator
+	singleSigner *singlesig.BlsSingleSigner
+}
+
+// NewBlsThresholdSigner creates a threshold signer that needs threshold out of numShares partial signatures
+func NewBlsThresholdSigner(threshold uint32, numShares uint32) (*BlsThresholdSigner, error) {
+	if numShares == 0 {
+		return nil, crypto.ErrInvalidParam
+	}
+	if threshold == 0 || threshold > numShares {
+		return nil, crypto.ErrInvalidThreshold
+	}
+
+	return &BlsThresholdSigner{
+		threshold:    threshold,
+		numShares:    numShares,
+		keyGen:       signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		singleSigner: singlesig.NewBlsSigner(),
+	}, nil
+}
+
+// SplitKey splits the group private key into numShares key shares, with indexes from 1 to numShares.
+// The dealer should discard the group private key and the shares it does not own after distributing them
+func (bts *BlsThresholdSigner) SplitKey(privateKey crypto.PrivateKey) ([]*KeyShare, error) {
+	if check.IfNil(privateKey) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+
+	scalar := privateKey.Scalar()
+	if check.IfNil(scalar) {
+		return nil, crypto.ErrNilPrivateKeyScalar
+	}
+
+	mclScalar, ok := scalar.(*mcl.Scalar)
+	if !ok || !singlesig.IsSecretKeyValid(mclScalar) {
+		return nil, crypto.ErrInvalidPrivateKey
+	}
+
+	poly, err := newRandomPolynomial(mclScalar, bts.threshold-1)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]*KeyShare, 0, bts.numShares)
+	for index := uint32(1); index <= bts.numShares; index++ {
+		share, errCreate := bts.createKeyShare(poly, index)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		shares = append(shares, share)
+	}
+
+	return shares, nil
+}
+
+func (bts *BlsThresholdSigner) createKeyShare(poly polynomial, index uint32) (*KeyShare, error) {
+	shareScalar, err := poly.evaluate(index)
+	if err != nil {
+		return nil, err
+	}
+
+	shareBytes, err := shareScalar.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	sharePrivateKey, err := bts.keyGen.PrivateKeyFromByteArray(shareBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyShare{
+		Index:      index,
+		PrivateKey: sharePrivateKey,
+		PublicKey:  sharePrivateKey.GeneratePublic(),
+	}, nil
+}
+
+// SignShare creates the partial signature of the message with the given key share
+func (bts *BlsThresholdSigner) SignShare(share *KeyShare, message []byte) (*SignatureShare, error) {
+	if share == nil {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	err := bts.checkIndex(share.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := bts.singleSigner.Sign(share.PrivateKey, message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignatureShare{
+		Index:     share.Index,
+		Signature: sig,
+	}, nil
+}
+
+// VerifySignatureShare verifies the partial signature of the message against the public key of the key share
+// that has the same index
+func (bts *BlsThresholdSigner) VerifySignatureShare(sharePubKey crypto.PublicKey, message []byte, sigShare *SignatureShare) error {
+	if sigShare == nil {
+		return crypto.ErrNilSignature
+	}
+	err := bts.checkIndex(sigShare.Index)
+	if err != nil {
+		return err
+	}
+
+	return bts.singleSigner.Verify(sharePubKey, message, sigShare.Signature)
+}
+
+// RecoverSignature combines, through Lagrange interpolation, the first threshold partial signatures into the
+// signature of the group private key. The partial signatures are expected to be already verified, as an invalid
+// share results in an invalid group signature
+func (bts *BlsThresholdSigner) RecoverSignature(sigShares []*SignatureShare) ([]byte, error) {
+	if uint32(len(sigShares)) < bts.threshold {
+		return nil, crypto.ErrNotEnoughShares
+	}
+
+	sigShares = sigShares[:bts.threshold]
+	indexes := make([]uint32, 0, len(sigShares))
+	sigPoints := make([]crypto.Point, 0, len(sigShares))
+	for _, sigShare := range sigShares {
+		if sigShare == nil {
+			return nil, crypto.ErrNilSignature
+		}
+		err := bts.checkIndex(sigShare.Index)
+		if err != nil {
+			return nil, err
+		}
+
+		sigPoint, err := sigBytesToPoint(sigShare.Signature)
+		if err != nil {
+			return nil, err
+		}
+
+		indexes = append(indexes, sigShare.Index)
+		sigPoints = append(sigPoints, sigPoint)
+	}
+
+	coefficients, err := lagrangeCoefficientsAtZero(indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	groupSig := mcl.NewPointG1().Null()
+	for i := range sigPoints {
+		weightedSig, errMul := sigPoints[i].Mul(coefficients[i])
+		if errMul != nil {
+			return nil, errMul
+		}
+
+		groupSig, err = groupSig.Add(weightedSig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return groupSig.MarshalBinary()
+}
+
+// Threshold returns the number of partial signatures needed to recover the group signature
+func (bts *BlsThresholdSigner) Threshold() uint32 {
+	return bts.threshold
+}
+
+// NumShares returns the number of key shares
+func (bts *BlsThresholdSigner) NumShares() uint32 {
+	return bts.numShares
+}
+
+func (bts *BlsThresholdSigner) checkIndex(index uint32) error {
+	if index == 0 || index > bts.numShares {
+		return crypto.ErrInvalidShareIndex
+	}
+
+	return nil
+}
+
+func sigBytesToPoint(sig []byte) (crypto.Point, error) {
+	if len(sig) == 0 {
+		return nil, crypto.ErrNilSignature
+	}
+
+	sigPoint := mcl.NewPointG1()
+	err := sigPoint.UnmarshalBinary(sig)
+	if err != nil {
+		return nil, err
+	}
+	if !singlesig.IsSigValidPoint(bls.CastToSign(sigPoint.G1)) {
+		return nil, crypto.ErrBLSInvalidSignature
+	}
+
+	return sigPoint, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bts *BlsThresholdSigner) IsInterfaceNil() bool {
+	return bts == nil
+}
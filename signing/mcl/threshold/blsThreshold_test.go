@@ -0,0 +1,215 @@
+package threshold_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/threshold"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createKeyShares(t *testing.T, thresh uint32, numShares uint32) (*threshold.BlsThresholdSigner, crypto.PrivateKey, crypto.PublicKey, []*threshold.KeyShare) {
+	thresholdSigner, err := threshold.NewBlsThresholdSigner(thresh, numShares)
+	require.Nil(t, err)
+
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	groupPrivKey, groupPubKey := kg.GeneratePair()
+	shares, err := thresholdSigner.SplitKey(groupPrivKey)
+	require.Nil(t, err)
+	require.Equal(t, int(numShares), len(shares))
+
+	return thresholdSigner, groupPrivKey, groupPubKey, shares
+}
+
+func signShares(t *testing.T, thresholdSigner *threshold.BlsThresholdSigner, shares []*threshold.KeyShare, indexes []int, msg []byte) []*threshold.SignatureShare {
+	sigShares := make([]*threshold.SignatureShare, 0, len(indexes))
+	for _, idx := range indexes {
+		sigShare, err := thresholdSigner.SignShare(shares[idx], msg)
+		require.Nil(t, err)
+
+		sigShares = append(sigShares, sigShare)
+	}
+
+	return sigShares
+}
+
+func TestNewBlsThresholdSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero shares should err", func(t *testing.T) {
+		thresholdSigner, err := threshold.NewBlsThresholdSigner(0, 0)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+		assert.True(t, check.IfNil(thresholdSigner))
+	})
+	t.Run("zero threshold should err", func(t *testing.T) {
+		thresholdSigner, err := threshold.NewBlsThresholdSigner(0, 5)
+		assert.Equal(t, crypto.ErrInvalidThreshold, err)
+		assert.True(t, check.IfNil(thresholdSigner))
+	})
+	t.Run("threshold higher than the number of shares should err", func(t *testing.T) {
+		thresholdSigner, err := threshold.NewBlsThresholdSigner(6, 5)
+		assert.Equal(t, crypto.ErrInvalidThreshold, err)
+		assert.True(t, check.IfNil(thresholdSigner))
+	})
+	t.Run("should work", func(t *testing.T) {
+		thresholdSigner, err := threshold.NewBlsThresholdSigner(3, 5)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(thresholdSigner))
+		assert.Equal(t, uint32(3), thresholdSigner.Threshold())
+		assert.Equal(t, uint32(5), thresholdSigner.NumShares())
+	})
+}
+
+func TestBlsThresholdSigner_SplitKey(t *testing.T) {
+	t.Parallel()
+
+	thresholdSigner, _ := threshold.NewBlsThresholdSigner(3, 5)
+
+	t.Run("nil private key should err", func(t *testing.T) {
+		shares, err := thresholdSigner.SplitKey(nil)
+		assert.Equal(t, crypto.ErrNilPrivateKey, err)
+		assert.Nil(t, shares)
+	})
+	t.Run("not BLS private key should err", func(t *testing.T) {
+		privKey, _ := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+		shares, err := thresholdSigner.SplitKey(privKey)
+		assert.Equal(t, crypto.ErrInvalidPrivateKey, err)
+		assert.Nil(t, shares)
+	})
+	t.Run("should work", func(t *testing.T) {
+		_, groupPrivKey, _, shares := createKeyShares(t, 3, 5)
+		groupPrivKeyBytes, _ := groupPrivKey.ToByteArray()
+		for i, share := range shares {
+			assert.Equal(t, uint32(i+1), share.Index)
+
+			sharePrivKeyBytes, _ := share.PrivateKey.ToByteArray()
+			assert.NotEqual(t, groupPrivKeyBytes, sharePrivKeyBytes)
+			assert.Equal(t, share.PrivateKey.GeneratePublic(), share.PublicKey)
+		}
+	})
+}
+
+func TestBlsThresholdSigner_SignAndVerifyShare(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	thresholdSigner, _, _, shares := createKeyShares(t, 3, 5)
+
+	sigShare, err := thresholdSigner.SignShare(nil, msg)
+	assert.Equal(t, crypto.ErrNilPrivateKey, err)
+	assert.Nil(t, sigShare)
+
+	sigShare, err = thresholdSigner.SignShare(&threshold.KeyShare{Index: 6, PrivateKey: shares[0].PrivateKey}, msg)
+	assert.Equal(t, crypto.ErrInvalidShareIndex, err)
+	assert.Nil(t, sigShare)
+
+	sigShare, err = thresholdSigner.SignShare(shares[1], msg)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), sigShare.Index)
+
+	err = thresholdSigner.VerifySignatureShare(shares[1].PublicKey, msg, nil)
+	assert.Equal(t, crypto.ErrNilSignature, err)
+
+	err = thresholdSigner.VerifySignatureShare(shares[1].PublicKey, msg, &threshold.SignatureShare{Index: 0, Signature: sigShare.Signature})
+	assert.Equal(t, crypto.ErrInvalidShareIndex, err)
+
+	err = thresholdSigner.VerifySignatureShare(shares[2].PublicKey, msg, sigShare)
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+
+	err = thresholdSigner.VerifySignatureShare(shares[1].PublicKey, []byte("other message"), sigShare)
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+
+	err = thresholdSigner.VerifySignatureShare(shares[1].PublicKey, msg, sigShare)
+	assert.Nil(t, err)
+}
+
+func TestBlsThresholdSigner_RecoverSignature(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	thresholdSigner, groupPrivKey, groupPubKey, shares := createKeyShares(t, 3, 5)
+	singleSigner := singlesig.NewBlsSigner()
+	expectedSig, err := singleSigner.Sign(groupPrivKey, msg)
+	require.Nil(t, err)
+
+	t.Run("not enough shares should err", func(t *testing.T) {
+		sigShares := signShares(t, thresholdSigner, shares, []int{0, 4}, msg)
+		groupSig, errRecover := thresholdSigner.RecoverSignature(sigShares)
+		assert.Equal(t, crypto.ErrNotEnoughShares, errRecover)
+		assert.Nil(t, groupSig)
+	})
+	t.Run("duplicated share index should err", func(t *testing.T) {
+		sigShares := signShares(t, thresholdSigner, shares, []int{0, 4, 0}, msg)
+		groupSig, errRecover := thresholdSigner.RecoverSignature(sigShares)
+		assert.Equal(t, crypto.ErrDuplicatedShareIndex, errRecover)
+		assert.Nil(t, groupSig)
+	})
+	t.Run("invalid share index should err", func(t *testing.T) {
+		sigShares := signShares(t, thresholdSigner, shares, []int{0, 1, 2}, msg)
+		sigShares[2] = &threshold.SignatureShare{Index: 7, Signature: sigShares[2].Signature}
+		groupSig, errRecover := thresholdSigner.RecoverSignature(sigShares)
+		assert.Equal(t, crypto.ErrInvalidShareIndex, errRecover)
+		assert.Nil(t, groupSig)
+	})
+	t.Run("invalid signature share should err", func(t *testing.T) {
+		sigShares := signShares(t, thresholdSigner, shares, []int{0, 1, 2}, msg)
+		sigShares[1] = &threshold.SignatureShare{Index: 2, Signature: []byte("invalid signature")}
+		groupSig, errRecover := thresholdSigner.RecoverSignature(sigShares)
+		assert.NotNil(t, errRecover)
+		assert.Nil(t, groupSig)
+	})
+	t.Run("signature share of another message should produce an invalid signature", func(t *testing.T) {
+		sigShares := signShares(t, thresholdSigner, shares, []int{0, 1}, msg)
+		sigShares = append(sigShares, signShares(t, thresholdSigner, shares, []int{2}, []byte("other message"))...)
+		groupSig, errRecover := thresholdSigner.RecoverSignature(sigShares)
+		require.Nil(t, errRecover)
+		assert.Equal(t, crypto.ErrSigNotValid, singleSigner.Verify(groupPubKey, msg, groupSig))
+	})
+	t.Run("any threshold shares should recover the group signature", func(t *testing.T) {
+		subsets := [][]int{
+			{0, 1, 2},
+			{4, 2, 0},
+			{1, 3, 4},
+			{3, 4, 1, 0, 2},
+		}
+		for _, subset := range subsets {
+			sigShares := signShares(t, thresholdSigner, shares, subset, msg)
+			for i, sigShare := range sigShares {
+				require.Nil(t, thresholdSigner.VerifySignatureShare(shares[subset[i]].PublicKey, msg, sigShare))
+			}
+
+			groupSig, errRecover := thresholdSigner.RecoverSignature(sigShares)
+			require.Nil(t, errRecover)
+			assert.Equal(t, expectedSig, groupSig)
+			assert.Nil(t, singleSigner.Verify(groupPubKey, msg, groupSig))
+		}
+	})
+}
+
+func TestBlsThresholdSigner_RecoverSignatureEdgeThresholds(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	singleSigner := singlesig.NewBlsSigner()
+
+	t.Run("1 of 4", func(t *testing.T) {
+		thresholdSigner, _, groupPubKey, shares := createKeyShares(t, 1, 4)
+		for i := range shares {
+			groupSig, err := thresholdSigner.RecoverSignature(signShares(t, thresholdSigner, shares, []int{i}, msg))
+			require.Nil(t, err)
+			assert.Nil(t, singleSigner.Verify(groupPubKey, msg, groupSig))
+		}
+	})
+	t.Run("4 of 4", func(t *testing.T) {
+		thresholdSigner, _, groupPubKey, shares := createKeyShares(t, 4, 4)
+		groupSig, err := thresholdSigner.RecoverSignature(signShares(t, thresholdSigner, shares, []int{3, 1, 0, 2}, msg))
+		require.Nil(t, err)
+		assert.Nil(t, singleSigner.Verify(groupPubKey, msg, groupSig))
+	})
+}
@@ -0,0 +1,321 @@
+package threshold
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("crypto/signing/mcl/threshold")
+
+type dkgPhase uint8
+
+const (
+	phaseDeal dkgPhase = iota
+	phaseProcessDeals
+	phaseProcessComplaints
+	phaseProcessJustifications
+	phaseFinalize
+)
+
+// DKGResult holds the outcome of a distributed key generation for one participant
+type DKGResult struct {
+	// Index is the index of the participant, which is also the index of its key share
+	Index uint32
+	// Share is the share of the group private key owned by the participant
+	Share *mcl.Scalar
+	// GroupPublicKey is the public key of the group
+	GroupPublicKey *mcl.PointG2
+	// PublicShares[i] is the public key of the share with index i+1
+	PublicShares []*mcl.PointG2
+	// Qualified holds the sorted indexes of the dealers that contributed to the group key
+	Qualified []uint32
+}
+
+// KeyShare returns the key share of the participant, to be used with the BlsThresholdSigner
+func (result *DKGResult) KeyShare() (*KeyShare, error) {
+	shareBytes, err := result.Share.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, err := keyGen.PrivateKeyFromByteArray(shareBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyShare{
+		Index:      result.Index,
+		PrivateKey: privateKey,
+		PublicKey:  privateKey.GeneratePublic(),
+	}, nil
+}
+
+// DKGParticipant runs the joint-Feldman distributed key generation protocol, in which n participants create a
+// BLS12-381 group key without a trusted dealer. Every participant acts as a dealer of a random secret and the group
+// private key is the sum of the secrets of the qualified dealers, so it is never known by any party.
+// The protocol has three phases:
+//   - deal: every dealer broadcasts the commitments of its polynomial and the encrypted shares of the participants
+//   - complaints: every participant complains against the dealers that sent an invalid share
+//   - justifications: every dealer reveals the shares of the complainers; dealers that do not answer all
+//     complaints with valid shares are disqualified
+//
+// The participants are identified by ed25519 identity keys, used to sign the messages and to encrypt the shares.
+// The transport is expected to provide a reliable broadcast, so that all honest participants see the same messages
+type DKGParticipant struct {
+	sessionID    []byte
+	index        uint32
+	threshold    uint32
+	identityKey  crypto.PrivateKey
+	participants []crypto.PublicKey
+	messenger    *messenger
+	phase        dkgPhase
+	dealer       *dealer
+	receiver     *receiver
+}
+
+// NewDKGParticipant creates a participant of a distributed key generation with threshold t. The participants slice
+// holds the ordered identity public keys of all the participants, the own one included, and the participant
+// on position i has the index i+1. The session ID must be unique for every run of the protocol, such as the epoch
+// the group key is generated for, and is bound to all the messages and shares, so they can not be replayed in
+// another run
+func NewDKGParticipant(
+	sessionID []byte,
+	identityKey crypto.PrivateKey,
+	participants []crypto.PublicKey,
+	threshold uint32,
+	transport crypto.DKGTransport,
+) (*DKGParticipant, error) {
+	if len(sessionID) == 0 {
+		return nil, crypto.ErrNilSessionID
+	}
+	if check.IfNil(identityKey) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if len(participants) == 0 {
+		return nil, crypto.ErrNilPublicKeys
+	}
+	if threshold == 0 || int(threshold) > len(participants) {
+		return nil, crypto.ErrInvalidThreshold
+	}
+	if check.IfNil(transport) {
+		return nil, crypto.ErrNilDKGTransport
+	}
+
+	index, err := findParticipantIndex(identityKey.GeneratePublic(), participants)
+	if err != nil {
+		return nil, err
+	}
+	if index == 0 {
+		return nil, crypto.ErrParticipantNotFound
+	}
+
+	return &DKGParticipant{
+		sessionID:    sessionID,
+		index:        index,
+		threshold:    threshold,
+		identityKey:  identityKey,
+		participants: participants,
+		messenger: &messenger{
+			sessionID: sessionID,
+			transport: transport,
+			signer:    &singlesig.Ed25519Signer{},
+		},
+		phase:    phaseDeal,
+		receiver: newReceiver(sessionID, index, identityKey, participants, len(participants), threshold, nil),
+	}, nil
+}
+
+// findParticipantIndex returns the index of the own public key in the participants slice, or 0 if not found
+func findParticipantIndex(ownPubKey crypto.PublicKey, participants []crypto.PublicKey) (uint32, error) {
+	ownPubKeyBytes, err := ownPubKey.ToByteArray()
+	if err != nil {
+		return 0, err
+	}
+
+	index := uint32(0)
+	seen := make(map[string]struct{}, len(participants))
+	for i, participant := range participants {
+		if check.IfNil(participant) {
+			return 0, crypto.ErrNilPublicKey
+		}
+
+		pubKeyBytes, errBytes := participant.ToByteArray()
+		if errBytes != nil {
+			return 0, errBytes
+		}
+		_, found := seen[string(pubKeyBytes)]
+		if found {
+			return 0, crypto.ErrInvalidParam
+		}
+		seen[string(pubKeyBytes)] = struct{}{}
+
+		if bytes.Equal(pubKeyBytes, ownPubKeyBytes) {
+			index = uint32(i + 1)
+		}
+	}
+
+	return index, nil
+}
+
+// Index returns the index of the participant
+func (p *DKGParticipant) Index() uint32 {
+	return p.index
+}
+
+// Run executes all the phases of the protocol, exchanging the messages through the transport
+func (p *DKGParticipant) Run() (*DKGResult, error) {
+	deal, err := p.Deal()
+	if err != nil {
+		return nil, err
+	}
+	err = p.messenger.broadcast(DealsTopic, p.index, p.identityKey, deal)
+	if err != nil {
+		return nil, err
+	}
+	deals, err := p.messenger.receiveDeals(DealsTopic, p.participants, p.index)
+	if err != nil {
+		return nil, err
+	}
+
+	complaints, err := p.ProcessDeals(append(deals, deal))
+	if err != nil {
+		return nil, err
+	}
+	err = p.messenger.broadcast(ComplaintsTopic, p.index, p.identityKey, complaints)
+	if err != nil {
+		return nil, err
+	}
+	receivedComplaints, err := p.messenger.receiveComplaints(ComplaintsTopic, p.participants, p.index)
+	if err != nil {
+		return nil, err
+	}
+
+	justifications, err := p.ProcessComplaints(append(receivedComplaints, complaints...))
+	if err != nil {
+		return nil, err
+	}
+	err = p.messenger.broadcast(JustificationsTopic, p.index, p.identityKey, justifications)
+	if err != nil {
+		return nil, err
+	}
+	receivedJustifications, err := p.messenger.receiveJustifications(JustificationsTopic, p.participants, p.index)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.ProcessJustifications(append(receivedJustifications, justifications...))
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Finalize()
+}
+
+// Deal creates the secret polynomial of the participant and returns the deal to be broadcast
+func (p *DKGParticipant) Deal() (*Deal, error) {
+	if p.phase != phaseDeal {
+		return nil, crypto.ErrWrongDKGPhase
+	}
+
+	d, err := newDealer(p.sessionID, p.index, p.identityKey, p.participants, mcl.NewScalar(), p.threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	deal, err := d.deal()
+	if err != nil {
+		return nil, err
+	}
+
+	p.dealer = d
+	p.phase = phaseProcessDeals
+
+	return deal, nil
+}
+
+// ProcessDeals processes the deals of all the dealers, the own one included, and returns the complaints against
+// the dealers that sent an invalid share. Malformed deals and dealers that sent more than one deal are disqualified
+func (p *DKGParticipant) ProcessDeals(deals []*Deal) ([]*Complaint, error) {
+	if p.phase != phaseProcessDeals {
+		return nil, crypto.ErrWrongDKGPhase
+	}
+
+	complaints := p.receiver.processDeals(deals)
+	p.phase = phaseProcessComplaints
+
+	return complaints, nil
+}
+
+// ProcessComplaints records the complaints of all the participants, the own ones included, and returns the
+// justifications of the participant for the complaints against it
+func (p *DKGParticipant) ProcessComplaints(complaints []*Complaint) ([]*Justification, error) {
+	if p.phase != phaseProcessComplaints {
+		return nil, crypto.ErrWrongDKGPhase
+	}
+
+	p.receiver.processComplaints(complaints)
+	justifications, err := p.dealer.justify(complaints)
+	if err != nil {
+		return nil, err
+	}
+
+	p.phase = phaseProcessJustifications
+
+	return justifications, nil
+}
+
+// ProcessJustifications checks the justifications of all the dealers, the own ones included. The dealers with
+// invalid justifications or with unanswered complaints are disqualified
+func (p *DKGParticipant) ProcessJustifications(justifications []*Justification) error {
+	if p.phase != phaseProcessJustifications {
+		return crypto.ErrWrongDKGPhase
+	}
+
+	p.receiver.processJustifications(justifications)
+	p.phase = phaseFinalize
+
+	return nil
+}
+
+// Finalize computes the share of the participant, the group public key and the public keys of all the shares,
+// from the contributions of the qualified dealers
+func (p *DKGParticipant) Finalize() (*DKGResult, error) {
+	if p.phase != phaseFinalize {
+		return nil, crypto.ErrWrongDKGPhase
+	}
+
+	qualified := p.receiver.qualifiedDealers()
+	if uint32(len(qualified)) < p.threshold {
+		return nil, crypto.ErrNotEnoughQualifiedDealers
+	}
+
+	weights := make([]crypto.Scalar, 0, len(qualified))
+	for range qualified {
+		weights = append(weights, mcl.NewScalar().One())
+	}
+
+	share, groupPublicKey, publicShares, err := p.receiver.combine(qualified, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DKGResult{
+		Index:          p.index,
+		Share:          share,
+		GroupPublicKey: groupPublicKey,
+		PublicShares:   publicShares,
+		Qualified:      qualified,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *DKGParticipant) IsInterfaceNil() bool {
+	return p == nil
+}
@@ -0,0 +1,119 @@
+package threshold
+
+import (
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/encryption/x25519"
+)
+
+// dealer shares a secret with a committee of receivers, through a random polynomial that has the secret as free term
+type dealer struct {
+	sessionID   []byte
+	index       uint32
+	identityKey crypto.PrivateKey
+	receivers   []crypto.PublicKey
+	poly        polynomial
+}
+
+func newDealer(
+	sessionID []byte,
+	index uint32,
+	identityKey crypto.PrivateKey,
+	receivers []crypto.PublicKey,
+	secret crypto.Scalar,
+	threshold uint32,
+) (*dealer, error) {
+	poly, err := newRandomPolynomial(secret, threshold-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dealer{
+		sessionID:   sessionID,
+		index:       index,
+		identityKey: identityKey,
+		receivers:   receivers,
+		poly:        poly,
+	}, nil
+}
+
+// deal returns the commitments of the polynomial and the encrypted shares of all the receivers. Every encrypted
+// share is prefixed with its context, so it can not be replayed in another session or to another receiver
+func (d *dealer) deal() (*Deal, error) {
+	commitments, err := d.poly.commit()
+	if err != nil {
+		return nil, err
+	}
+
+	deal := &Deal{
+		DealerIndex:     d.index,
+		Commitments:     make([][]byte, 0, len(commitments)),
+		EncryptedShares: make([]*x25519.EncryptedData, 0, len(d.receivers)),
+	}
+	for _, commitment := range commitments {
+		commitmentBytes, errMarshal := commitment.MarshalBinary()
+		if errMarshal != nil {
+			return nil, errMarshal
+		}
+
+		deal.Commitments = append(deal.Commitments, commitmentBytes)
+	}
+
+	for i, receiver := range d.receivers {
+		receiverIndex := uint32(i + 1)
+		shareBytes, errShare := d.shareBytes(receiverIndex)
+		if errShare != nil {
+			return nil, errShare
+		}
+
+		plainText := append(shareContext(d.sessionID, d.index, receiverIndex), shareBytes...)
+		encryptedShare := &x25519.EncryptedData{}
+		err = encryptedShare.Encrypt(plainText, receiver, d.identityKey)
+		if err != nil {
+			return nil, err
+		}
+
+		deal.EncryptedShares = append(deal.EncryptedShares, encryptedShare)
+	}
+
+	return deal, nil
+}
+
+// justify returns the justifications for the complaints against the dealer
+func (d *dealer) justify(complaints []*Complaint) ([]*Justification, error) {
+	justifications := make([]*Justification, 0)
+	justified := make(map[uint32]struct{})
+	for _, complaint := range complaints {
+		if complaint == nil || complaint.DealerIndex != d.index {
+			continue
+		}
+
+		complainer := complaint.ComplainerIndex
+		_, alreadyJustified := justified[complainer]
+		if alreadyJustified || complainer == 0 || int(complainer) > len(d.receivers) {
+			continue
+		}
+		justified[complainer] = struct{}{}
+
+		shareBytes, err := d.shareBytes(complainer)
+		if err != nil {
+			return nil, err
+		}
+
+		justifications = append(justifications, &Justification{
+			DealerIndex:     d.index,
+			ComplainerIndex: complainer,
+			Share:           shareBytes,
+		})
+	}
+
+	return justifications, nil
+}
+
+func (d *dealer) shareBytes(receiverIndex uint32) ([]byte, error) {
+	share, err := d.poly.evaluate(receiverIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return share.MarshalBinary()
+}
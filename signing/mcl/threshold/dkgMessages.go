@@ -0,0 +1,72 @@
+package threshold
+
+import (
+	"encoding/binary"
+
+	"github.com/multiversx/mx-chain-crypto-go/encryption/x25519"
+)
+
+const (
+	// DealsTopic is the transport topic used for the deals
+	DealsTopic = "dkg_deals"
+	// ComplaintsTopic is the transport topic used for the complaints
+	ComplaintsTopic = "dkg_complaints"
+	// JustificationsTopic is the transport topic used for the justifications
+	JustificationsTopic = "dkg_justifications"
+)
+
+// Deal is broadcast by every dealer and holds the Feldman commitments of its secret polynomial, together with
+// the evaluations of the polynomial for every participant, each one encrypted for its recipient.
+// EncryptedShares[i] is the share of the participant with index i+1
+type Deal struct {
+	DealerIndex     uint32                  `json:"dealerIndex"`
+	Commitments     [][]byte                `json:"commitments"`
+	EncryptedShares []*x25519.EncryptedData `json:"encryptedShares"`
+}
+
+// Complaint is broadcast by a participant that received an invalid share from a dealer
+type Complaint struct {
+	ComplainerIndex uint32 `json:"complainerIndex"`
+	DealerIndex     uint32 `json:"dealerIndex"`
+}
+
+// Justification is broadcast by a dealer as answer to a complaint, and reveals the share of the complainer
+type Justification struct {
+	DealerIndex     uint32 `json:"dealerIndex"`
+	ComplainerIndex uint32 `json:"complainerIndex"`
+	Share           []byte `json:"share"`
+}
+
+// signedMessage wraps every message sent through the transport, signed with the identity key of the sender
+type signedMessage struct {
+	SessionID   []byte `json:"sessionID"`
+	SenderIndex uint32 `json:"senderIndex"`
+	Payload     []byte `json:"payload"`
+	Signature   []byte `json:"signature"`
+}
+
+// signedData returns the data covered by the signature of a message:
+// len(session ID) | session ID | len(topic) | topic | sender index | payload
+func signedData(sessionID []byte, topic string, senderIndex uint32, payload []byte) []byte {
+	data := make([]byte, 0, 4+len(sessionID)+4+len(topic)+4+len(payload))
+	data = binary.BigEndian.AppendUint32(data, uint32(len(sessionID)))
+	data = append(data, sessionID...)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(topic)))
+	data = append(data, topic...)
+	data = binary.BigEndian.AppendUint32(data, senderIndex)
+	data = append(data, payload...)
+
+	return data
+}
+
+// shareContext returns the prefix of an encrypted share plain text, which binds the share to the session, the
+// dealer and the receiver: len(session ID) | session ID | dealer index | receiver index
+func shareContext(sessionID []byte, dealerIndex uint32, receiverIndex uint32) []byte {
+	data := make([]byte, 0, 4+len(sessionID)+4+4)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(sessionID)))
+	data = append(data, sessionID...)
+	data = binary.BigEndian.AppendUint32(data, dealerIndex)
+	data = binary.BigEndian.AppendUint32(data, receiverIndex)
+
+	return data
+}
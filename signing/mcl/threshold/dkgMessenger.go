@@ -0,0 +1,117 @@
+package threshold
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+// messenger signs the messages sent through the transport with the identity key of the sender and drops the
+// received messages that belong to another session or are not signed by the member of the expected committee
+type messenger struct {
+	sessionID []byte
+	transport crypto.DKGTransport
+	signer    crypto.SingleSigner
+}
+
+func (m *messenger) broadcast(topic string, senderIndex uint32, identityKey crypto.PrivateKey, message interface{}) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	signature, err := m.signer.Sign(identityKey, signedData(m.sessionID, topic, senderIndex, payload))
+	if err != nil {
+		return err
+	}
+
+	envelope, err := json.Marshal(&signedMessage{
+		SessionID:   m.sessionID,
+		SenderIndex: senderIndex,
+		Payload:     payload,
+		Signature:   signature,
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.transport.Broadcast(topic, envelope)
+}
+
+// receive calls the handler for every message of the topic and of the own session that is signed by a member of the
+// senders committee. The own message, if ownIndex is not 0, is skipped
+func (m *messenger) receive(topic string, senders []crypto.PublicKey, ownIndex uint32, handler func(sender uint32, payload []byte)) error {
+	received, err := m.transport.Receive(topic)
+	if err != nil {
+		return err
+	}
+
+	for _, buff := range received {
+		msg := &signedMessage{}
+		err = json.Unmarshal(buff, msg)
+		if err != nil || !isValidIndex(msg.SenderIndex, len(senders)) || msg.SenderIndex == ownIndex {
+			continue
+		}
+		if !bytes.Equal(msg.SessionID, m.sessionID) {
+			log.Debug("messenger.receive: message of another session", "topic", topic, "sender", msg.SenderIndex)
+			continue
+		}
+
+		err = m.signer.Verify(senders[msg.SenderIndex-1], signedData(m.sessionID, topic, msg.SenderIndex, msg.Payload), msg.Signature)
+		if err != nil {
+			log.Debug("messenger.receive: invalid message signature", "topic", topic, "sender", msg.SenderIndex)
+			continue
+		}
+
+		handler(msg.SenderIndex, msg.Payload)
+	}
+
+	return nil
+}
+
+func (m *messenger) receiveDeals(topic string, senders []crypto.PublicKey, ownIndex uint32) ([]*Deal, error) {
+	deals := make([]*Deal, 0, len(senders))
+	err := m.receive(topic, senders, ownIndex, func(sender uint32, payload []byte) {
+		deal := &Deal{}
+		if json.Unmarshal(payload, deal) == nil && deal.DealerIndex == sender {
+			deals = append(deals, deal)
+		}
+	})
+
+	return deals, err
+}
+
+func (m *messenger) receiveComplaints(topic string, senders []crypto.PublicKey, ownIndex uint32) ([]*Complaint, error) {
+	complaints := make([]*Complaint, 0)
+	err := m.receive(topic, senders, ownIndex, func(sender uint32, payload []byte) {
+		var received []*Complaint
+		if json.Unmarshal(payload, &received) != nil {
+			return
+		}
+		for _, complaint := range received {
+			if complaint != nil && complaint.ComplainerIndex == sender {
+				complaints = append(complaints, complaint)
+			}
+		}
+	})
+
+	return complaints, err
+}
+
+func (m *messenger) receiveJustifications(topic string, senders []crypto.PublicKey, ownIndex uint32) ([]*Justification, error) {
+	justifications := make([]*Justification, 0)
+	err := m.receive(topic, senders, ownIndex, func(sender uint32, payload []byte) {
+		var received []*Justification
+		if json.Unmarshal(payload, &received) != nil {
+			return
+		}
+		for _, justification := range received {
+			if justification != nil && justification.DealerIndex == sender {
+				justifications = append(justifications, justification)
+			}
+		}
+	})
+
+	return justifications, err
+}
@@ -0,0 +1,299 @@
+package threshold
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/encryption/x25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+)
+
+// receiver collects and checks the shares dealt to one member of a committee, and tracks the complaints and
+// justifications that decide which dealers are qualified
+type receiver struct {
+	sessionID    []byte
+	index        uint32
+	identityKey  crypto.PrivateKey
+	dealers      []crypto.PublicKey
+	numReceivers int
+	threshold    uint32
+	// freeTerms, if set, holds for every dealer the expected commitment of the free term of its polynomial
+	freeTerms    []*mcl.PointG2
+	commitments  map[uint32][]crypto.Point
+	shares       map[uint32]crypto.Scalar
+	complaints   map[uint32]map[uint32]struct{}
+	disqualified map[uint32]struct{}
+}
+
+func newReceiver(
+	sessionID []byte,
+	index uint32,
+	identityKey crypto.PrivateKey,
+	dealers []crypto.PublicKey,
+	numReceivers int,
+	threshold uint32,
+	freeTerms []*mcl.PointG2,
+) *receiver {
+	return &receiver{
+		sessionID:    sessionID,
+		index:        index,
+		identityKey:  identityKey,
+		dealers:      dealers,
+		numReceivers: numReceivers,
+		threshold:    threshold,
+		freeTerms:    freeTerms,
+		commitments:  make(map[uint32][]crypto.Point),
+		shares:       make(map[uint32]crypto.Scalar),
+		complaints:   make(map[uint32]map[uint32]struct{}),
+		disqualified: make(map[uint32]struct{}),
+	}
+}
+
+// processDeals stores the valid shares and returns the complaints against the dealers that sent an invalid share.
+// Malformed deals and dealers that sent more than one deal are disqualified
+func (r *receiver) processDeals(deals []*Deal) []*Complaint {
+	numDeals := make(map[uint32]int)
+	for _, deal := range deals {
+		if deal != nil {
+			numDeals[deal.DealerIndex]++
+		}
+	}
+
+	complaints := make([]*Complaint, 0)
+	for _, deal := range deals {
+		if deal == nil || !isValidIndex(deal.DealerIndex, len(r.dealers)) {
+			continue
+		}
+
+		dealerIndex := deal.DealerIndex
+		commitments, err := r.decodeDeal(deal)
+		if err != nil || numDeals[dealerIndex] > 1 {
+			log.Debug("receiver.processDeals: dealer disqualified", "dealer", dealerIndex, "error", err)
+			r.disqualified[dealerIndex] = struct{}{}
+			continue
+		}
+
+		r.commitments[dealerIndex] = commitments
+		share, err := r.decryptShare(dealerIndex, deal.EncryptedShares[r.index-1])
+		if err != nil || !verifyShare(share, r.index, commitments) {
+			complaints = append(complaints, &Complaint{
+				ComplainerIndex: r.index,
+				DealerIndex:     dealerIndex,
+			})
+			continue
+		}
+
+		r.shares[dealerIndex] = share
+	}
+
+	return complaints
+}
+
+func (r *receiver) decodeDeal(deal *Deal) ([]crypto.Point, error) {
+	if len(deal.Commitments) != int(r.threshold) || len(deal.EncryptedShares) != r.numReceivers {
+		return nil, crypto.ErrInvalidDKGMessage
+	}
+
+	suite := mcl.NewSuiteBLS12()
+	commitments := make([]crypto.Point, 0, len(deal.Commitments))
+	for _, commitmentBytes := range deal.Commitments {
+		err := suite.CheckPointValid(commitmentBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		commitment := mcl.NewPointG2()
+		err = commitment.UnmarshalBinary(commitmentBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		commitments = append(commitments, commitment)
+	}
+
+	if len(r.freeTerms) > 0 {
+		isEqual, err := commitments[0].Equal(r.freeTerms[deal.DealerIndex-1])
+		if err != nil {
+			return nil, err
+		}
+		if !isEqual {
+			return nil, crypto.ErrInvalidDKGMessage
+		}
+	}
+
+	return commitments, nil
+}
+
+func (r *receiver) decryptShare(dealerIndex uint32, encryptedShare *x25519.EncryptedData) (crypto.Scalar, error) {
+	if encryptedShare == nil {
+		return nil, crypto.ErrInvalidDKGMessage
+	}
+
+	dealerPubKeyBytes, err := r.dealers[dealerIndex-1].ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	if encryptedShare.Identities.OriginatorPubKey != hex.EncodeToString(dealerPubKeyBytes) {
+		return nil, crypto.ErrInvalidDKGMessage
+	}
+
+	plainText, err := encryptedShare.Decrypt(r.identityKey)
+	if err != nil {
+		return nil, err
+	}
+
+	context := shareContext(r.sessionID, dealerIndex, r.index)
+	if !bytes.HasPrefix(plainText, context) {
+		return nil, crypto.ErrInvalidDKGMessage
+	}
+
+	return decodeShare(plainText[len(context):])
+}
+
+// processComplaints records the complaints of all the receivers against the dealers that are still qualified
+func (r *receiver) processComplaints(complaints []*Complaint) {
+	for _, complaint := range complaints {
+		if complaint == nil || !isValidIndex(complaint.ComplainerIndex, r.numReceivers) || !r.isDealerPending(complaint.DealerIndex) {
+			continue
+		}
+
+		complainers, found := r.complaints[complaint.DealerIndex]
+		if !found {
+			complainers = make(map[uint32]struct{})
+			r.complaints[complaint.DealerIndex] = complainers
+		}
+		complainers[complaint.ComplainerIndex] = struct{}{}
+	}
+}
+
+// processJustifications checks the justifications of the dealers. The dealers with invalid justifications
+// or with unanswered complaints are disqualified
+func (r *receiver) processJustifications(justifications []*Justification) {
+	for _, justification := range justifications {
+		if justification == nil || !r.isDealerPending(justification.DealerIndex) {
+			continue
+		}
+
+		dealerIndex := justification.DealerIndex
+		complainer := justification.ComplainerIndex
+		_, found := r.complaints[dealerIndex][complainer]
+		if !found {
+			continue
+		}
+
+		share, err := decodeShare(justification.Share)
+		if err != nil || !verifyShare(share, complainer, r.commitments[dealerIndex]) {
+			log.Debug("receiver.processJustifications: dealer disqualified", "dealer", dealerIndex, "complainer", complainer)
+			r.disqualified[dealerIndex] = struct{}{}
+			continue
+		}
+
+		delete(r.complaints[dealerIndex], complainer)
+		if complainer == r.index {
+			r.shares[dealerIndex] = share
+		}
+	}
+
+	for dealerIndex, complainers := range r.complaints {
+		if len(complainers) > 0 {
+			log.Debug("receiver.processJustifications: dealer disqualified for unanswered complaints",
+				"dealer", dealerIndex, "num complaints", len(complainers))
+			r.disqualified[dealerIndex] = struct{}{}
+		}
+	}
+}
+
+// qualifiedDealers returns the sorted indexes of the dealers that sent a valid deal and answered all the complaints
+func (r *receiver) qualifiedDealers() []uint32 {
+	qualified := make([]uint32, 0, len(r.commitments))
+	for dealerIndex := range r.commitments {
+		if r.isDealerPending(dealerIndex) {
+			qualified = append(qualified, dealerIndex)
+		}
+	}
+
+	sort.Slice(qualified, func(i, j int) bool {
+		return qualified[i] < qualified[j]
+	})
+
+	return qualified
+}
+
+// combine returns the sum of weights[i] * f_i, where f_i is the polynomial of dealers[i], as the share of the
+// receiver together with the commitment of the free term and the public keys of all the receivers' shares
+func (r *receiver) combine(dealers []uint32, weights []crypto.Scalar) (*mcl.Scalar, *mcl.PointG2, []*mcl.PointG2, error) {
+	var err error
+	share := mcl.NewScalar().Zero()
+	freeTerm := mcl.NewPointG2().Null()
+	for i, dealerIndex := range dealers {
+		dealerShare, found := r.shares[dealerIndex]
+		if !found {
+			return nil, nil, nil, crypto.ErrNotEnoughShares
+		}
+
+		weightedShare, errMul := dealerShare.Mul(weights[i])
+		if errMul != nil {
+			return nil, nil, nil, errMul
+		}
+		share, err = share.Add(weightedShare)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		weightedFreeTerm, errMul := r.commitments[dealerIndex][0].Mul(weights[i])
+		if errMul != nil {
+			return nil, nil, nil, errMul
+		}
+		freeTerm, err = freeTerm.Add(weightedFreeTerm)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	publicShares := make([]*mcl.PointG2, 0, r.numReceivers)
+	for receiverIndex := 1; receiverIndex <= r.numReceivers; receiverIndex++ {
+		publicShare := mcl.NewPointG2().Null()
+		for i, dealerIndex := range dealers {
+			dealerPublicShare, errEvaluate := evaluateCommitments(r.commitments[dealerIndex], uint32(receiverIndex))
+			if errEvaluate != nil {
+				return nil, nil, nil, errEvaluate
+			}
+
+			weightedPublicShare, errMul := dealerPublicShare.Mul(weights[i])
+			if errMul != nil {
+				return nil, nil, nil, errMul
+			}
+			publicShare, err = publicShare.Add(weightedPublicShare)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+
+		publicShares = append(publicShares, publicShare.(*mcl.PointG2))
+	}
+
+	return share.(*mcl.Scalar), freeTerm.(*mcl.PointG2), publicShares, nil
+}
+
+func (r *receiver) isDealerPending(dealerIndex uint32) bool {
+	_, hasDeal := r.commitments[dealerIndex]
+	_, isDisqualified := r.disqualified[dealerIndex]
+
+	return hasDeal && !isDisqualified
+}
+
+func isValidIndex(index uint32, committeeSize int) bool {
+	return index > 0 && int(index) <= committeeSize
+}
+
+func decodeShare(shareBytes []byte) (crypto.Scalar, error) {
+	share := mcl.NewScalar()
+	err := share.UnmarshalBinary(shareBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return share, nil
+}
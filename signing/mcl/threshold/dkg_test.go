@@ -0,0 +1,466 @@
+package threshold_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/threshold"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSessionID = []byte("epoch 1")
+
+// inMemoryNetwork delivers every broadcast message to all the transports of the network
+type inMemoryNetwork struct {
+	mut                     sync.Mutex
+	cond                    *sync.Cond
+	messages                map[string][][]byte
+	numBroadcasters         int
+	numBroadcastersPerTopic map[string]int
+}
+
+func newInMemoryNetwork(numBroadcasters int) *inMemoryNetwork {
+	network := &inMemoryNetwork{
+		messages:                make(map[string][][]byte),
+		numBroadcasters:         numBroadcasters,
+		numBroadcastersPerTopic: make(map[string]int),
+	}
+	network.cond = sync.NewCond(&network.mut)
+
+	return network
+}
+
+func (imn *inMemoryNetwork) expectedMessages(topic string) int {
+	numBroadcasters, found := imn.numBroadcastersPerTopic[topic]
+	if !found {
+		return imn.numBroadcasters
+	}
+
+	return numBroadcasters
+}
+
+type inMemoryTransport struct {
+	network *inMemoryNetwork
+}
+
+func (imt *inMemoryTransport) Broadcast(topic string, message []byte) error {
+	imt.network.mut.Lock()
+	defer imt.network.mut.Unlock()
+
+	imt.network.messages[topic] = append(imt.network.messages[topic], message)
+	imt.network.cond.Broadcast()
+
+	return nil
+}
+
+func (imt *inMemoryTransport) Receive(topic string) ([][]byte, error) {
+	imt.network.mut.Lock()
+	defer imt.network.mut.Unlock()
+
+	for len(imt.network.messages[topic]) < imt.network.expectedMessages(topic) {
+		imt.network.cond.Wait()
+	}
+
+	return append([][]byte{}, imt.network.messages[topic]...), nil
+}
+
+func (imt *inMemoryTransport) IsInterfaceNil() bool {
+	return imt == nil
+}
+
+func createIdentities(n int) ([]crypto.PrivateKey, []crypto.PublicKey) {
+	kg := signing.NewKeyGenerator(ed25519.NewEd25519())
+	privKeys := make([]crypto.PrivateKey, 0, n)
+	pubKeys := make([]crypto.PublicKey, 0, n)
+	for i := 0; i < n; i++ {
+		privKey, pubKey := kg.GeneratePair()
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	return privKeys, pubKeys
+}
+
+func createDKGParticipants(t *testing.T, n int, thresh uint32, transport crypto.DKGTransport) []*threshold.DKGParticipant {
+	privKeys, pubKeys := createIdentities(n)
+
+	return createDKGParticipantsWithIdentities(t, testSessionID, privKeys, pubKeys, thresh, transport)
+}
+
+func createDKGParticipantsWithIdentities(
+	t *testing.T,
+	sessionID []byte,
+	privKeys []crypto.PrivateKey,
+	pubKeys []crypto.PublicKey,
+	thresh uint32,
+	transport crypto.DKGTransport,
+) []*threshold.DKGParticipant {
+	n := len(privKeys)
+	participants := make([]*threshold.DKGParticipant, 0, n)
+	for i := 0; i < n; i++ {
+		participant, err := threshold.NewDKGParticipant(sessionID, privKeys[i], pubKeys, thresh, transport)
+		require.Nil(t, err)
+		require.Equal(t, uint32(i+1), participant.Index())
+
+		participants = append(participants, participant)
+	}
+
+	return participants
+}
+
+func runDKG(t *testing.T, participants []*threshold.DKGParticipant) []*threshold.DKGResult {
+	results := make([]*threshold.DKGResult, len(participants))
+	wg := sync.WaitGroup{}
+	wg.Add(len(participants))
+	for i := range participants {
+		go func(idx int) {
+			defer wg.Done()
+
+			result, err := participants[idx].Run()
+			assert.Nil(t, err)
+			results[idx] = result
+		}(i)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// runDKGPhases runs the protocol phase by phase, allowing the tests to alter the messages of a dealer
+func runDKGPhases(
+	t *testing.T,
+	participants []*threshold.DKGParticipant,
+	alterDeals func(deals []*threshold.Deal),
+	alterJustifications func(justifications []*threshold.Justification) []*threshold.Justification,
+) []*threshold.DKGResult {
+	deals := make([]*threshold.Deal, 0, len(participants))
+	for _, participant := range participants {
+		deal, err := participant.Deal()
+		require.Nil(t, err)
+		deals = append(deals, deal)
+	}
+	alterDeals(deals)
+
+	complaints := make([]*threshold.Complaint, 0)
+	for _, participant := range participants {
+		participantComplaints, err := participant.ProcessDeals(deals)
+		require.Nil(t, err)
+		complaints = append(complaints, participantComplaints...)
+	}
+
+	justifications := make([]*threshold.Justification, 0)
+	for _, participant := range participants {
+		participantJustifications, err := participant.ProcessComplaints(complaints)
+		require.Nil(t, err)
+		justifications = append(justifications, participantJustifications...)
+	}
+	justifications = alterJustifications(justifications)
+
+	results := make([]*threshold.DKGResult, 0, len(participants))
+	for _, participant := range participants {
+		err := participant.ProcessJustifications(justifications)
+		require.Nil(t, err)
+
+		result, err := participant.Finalize()
+		require.Nil(t, err)
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func checkDKGResults(t *testing.T, results []*threshold.DKGResult, thresh uint32, expectedQualified []uint32) {
+	for _, result := range results {
+		require.NotNil(t, result)
+		assert.Equal(t, expectedQualified, result.Qualified)
+		assertEqualPoints(t, results[0].GroupPublicKey, result.GroupPublicKey)
+		require.Equal(t, len(results[0].PublicShares), len(result.PublicShares))
+		for i := range result.PublicShares {
+			assertEqualPoints(t, results[0].PublicShares[i], result.PublicShares[i])
+		}
+
+		publicShare, err := mcl.NewPointG2().Mul(result.Share)
+		require.Nil(t, err)
+		isEqual, _ := publicShare.Equal(result.PublicShares[result.Index-1])
+		assert.True(t, isEqual)
+	}
+
+	// any threshold participants should produce a valid signature for the group public key
+	msg := []byte("message")
+	thresholdSigner, err := threshold.NewBlsThresholdSigner(thresh, uint32(len(results[0].PublicShares)))
+	require.Nil(t, err)
+
+	sigShares := make([]*threshold.SignatureShare, 0, thresh)
+	for _, result := range results[len(results)-int(thresh):] {
+		keyShare, errShare := result.KeyShare()
+		require.Nil(t, errShare)
+
+		sigShare, errSign := thresholdSigner.SignShare(keyShare, msg)
+		require.Nil(t, errSign)
+		sigShares = append(sigShares, sigShare)
+	}
+
+	groupSig, err := thresholdSigner.RecoverSignature(sigShares)
+	require.Nil(t, err)
+
+	assert.Nil(t, singlesig.NewBlsSigner().Verify(createBLSPublicKey(t, results[0].GroupPublicKey), msg, groupSig))
+}
+
+func TestNewDKGParticipant(t *testing.T) {
+	t.Parallel()
+
+	privKeys, pubKeys := createIdentities(4)
+	transport := &inMemoryTransport{network: newInMemoryNetwork(4)}
+
+	t.Run("empty session ID should err", func(t *testing.T) {
+		participant, err := threshold.NewDKGParticipant(nil, privKeys[0], pubKeys, 3, transport)
+		assert.Equal(t, crypto.ErrNilSessionID, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("nil identity key should err", func(t *testing.T) {
+		participant, err := threshold.NewDKGParticipant(testSessionID, nil, pubKeys, 3, transport)
+		assert.Equal(t, crypto.ErrNilPrivateKey, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("empty participants should err", func(t *testing.T) {
+		participant, err := threshold.NewDKGParticipant(testSessionID, privKeys[0], nil, 3, transport)
+		assert.Equal(t, crypto.ErrNilPublicKeys, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("invalid threshold should err", func(t *testing.T) {
+		participant, err := threshold.NewDKGParticipant(testSessionID, privKeys[0], pubKeys, 0, transport)
+		assert.Equal(t, crypto.ErrInvalidThreshold, err)
+		assert.True(t, check.IfNil(participant))
+
+		participant, err = threshold.NewDKGParticipant(testSessionID, privKeys[0], pubKeys, 5, transport)
+		assert.Equal(t, crypto.ErrInvalidThreshold, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("nil transport should err", func(t *testing.T) {
+		participant, err := threshold.NewDKGParticipant(testSessionID, privKeys[0], pubKeys, 3, nil)
+		assert.Equal(t, crypto.ErrNilDKGTransport, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("nil participant should err", func(t *testing.T) {
+		participants := []crypto.PublicKey{pubKeys[0], nil, pubKeys[2]}
+		participant, err := threshold.NewDKGParticipant(testSessionID, privKeys[0], participants, 2, transport)
+		assert.Equal(t, crypto.ErrNilPublicKey, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("duplicated participant should err", func(t *testing.T) {
+		participants := []crypto.PublicKey{pubKeys[0], pubKeys[1], pubKeys[1]}
+		participant, err := threshold.NewDKGParticipant(testSessionID, privKeys[0], participants, 2, transport)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("own identity not in participants should err", func(t *testing.T) {
+		participant, err := threshold.NewDKGParticipant(testSessionID, privKeys[3], pubKeys[:3], 2, transport)
+		assert.Equal(t, crypto.ErrParticipantNotFound, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("should work", func(t *testing.T) {
+		participant, err := threshold.NewDKGParticipant(testSessionID, privKeys[2], pubKeys, 3, transport)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(participant))
+		assert.Equal(t, uint32(3), participant.Index())
+	})
+}
+
+func TestDKGParticipant_WrongPhaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	participant := createDKGParticipants(t, 3, 2, &inMemoryTransport{network: newInMemoryNetwork(3)})[0]
+
+	complaints, err := participant.ProcessDeals(nil)
+	assert.Equal(t, crypto.ErrWrongDKGPhase, err)
+	assert.Nil(t, complaints)
+
+	justifications, err := participant.ProcessComplaints(nil)
+	assert.Equal(t, crypto.ErrWrongDKGPhase, err)
+	assert.Nil(t, justifications)
+
+	err = participant.ProcessJustifications(nil)
+	assert.Equal(t, crypto.ErrWrongDKGPhase, err)
+
+	result, err := participant.Finalize()
+	assert.Equal(t, crypto.ErrWrongDKGPhase, err)
+	assert.Nil(t, result)
+
+	_, err = participant.Deal()
+	require.Nil(t, err)
+	deal, err := participant.Deal()
+	assert.Equal(t, crypto.ErrWrongDKGPhase, err)
+	assert.Nil(t, deal)
+}
+
+func TestDKGParticipant_RunInMemory(t *testing.T) {
+	t.Parallel()
+
+	n := 7
+	thresh := uint32(4)
+	participants := createDKGParticipants(t, n, thresh, &inMemoryTransport{network: newInMemoryNetwork(n)})
+
+	results := runDKG(t, participants)
+	checkDKGResults(t, results, thresh, []uint32{1, 2, 3, 4, 5, 6, 7})
+}
+
+func TestDKGParticipant_RunWithSilentParticipant(t *testing.T) {
+	t.Parallel()
+
+	n := 5
+	thresh := uint32(3)
+	participants := createDKGParticipants(t, n, thresh, &inMemoryTransport{network: newInMemoryNetwork(n - 1)})
+
+	// participant with index 2 never sends its deal
+	online := append([]*threshold.DKGParticipant{participants[0]}, participants[2:]...)
+	results := runDKG(t, online)
+	checkDKGResults(t, results, thresh, []uint32{1, 3, 4, 5})
+}
+
+func TestDKGParticipant_ReplayedDealOfAnotherSessionShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	n := 4
+	thresh := uint32(3)
+	privKeys, pubKeys := createIdentities(n)
+	oldNetwork := newInMemoryNetwork(n)
+	oldParticipants := createDKGParticipantsWithIdentities(t, []byte("epoch 0"), privKeys, pubKeys, thresh, &inMemoryTransport{network: oldNetwork})
+	_ = runDKG(t, oldParticipants)
+
+	// an old deal is replayed in the new session, which would disqualify its dealer for dealing twice
+	network := newInMemoryNetwork(n)
+	network.messages[threshold.DealsTopic] = [][]byte{oldNetwork.messages[threshold.DealsTopic][0]}
+	network.numBroadcastersPerTopic[threshold.DealsTopic] = n + 1
+	participants := createDKGParticipantsWithIdentities(t, testSessionID, privKeys, pubKeys, thresh, &inMemoryTransport{network: network})
+
+	results := runDKG(t, participants)
+	checkDKGResults(t, results, thresh, []uint32{1, 2, 3, 4})
+}
+
+func TestDKGParticipant_ShareOfAnotherSessionShouldBeComplainedAbout(t *testing.T) {
+	t.Parallel()
+
+	privKeys, pubKeys := createIdentities(3)
+	transport := &inMemoryTransport{network: newInMemoryNetwork(3)}
+	oldParticipants := createDKGParticipantsWithIdentities(t, []byte("epoch 0"), privKeys, pubKeys, 2, transport)
+	participants := createDKGParticipantsWithIdentities(t, testSessionID, privKeys, pubKeys, 2, transport)
+
+	oldDeal, err := oldParticipants[0].Deal()
+	require.Nil(t, err)
+	_, err = participants[1].Deal()
+	require.Nil(t, err)
+
+	complaints, err := participants[1].ProcessDeals([]*threshold.Deal{oldDeal})
+	require.Nil(t, err)
+	require.Equal(t, []*threshold.Complaint{{ComplainerIndex: 2, DealerIndex: 1}}, complaints)
+}
+
+func TestDKGParticipant_ComplaintAnsweredShouldKeepDealer(t *testing.T) {
+	t.Parallel()
+
+	thresh := uint32(3)
+	participants := createDKGParticipants(t, 5, thresh, &inMemoryTransport{network: newInMemoryNetwork(5)})
+
+	results := runDKGPhases(
+		t,
+		participants,
+		func(deals []*threshold.Deal) {
+			// dealer 1 sends to participant 2 the share of participant 3
+			deals[0].EncryptedShares[1] = deals[0].EncryptedShares[2]
+		},
+		func(justifications []*threshold.Justification) []*threshold.Justification {
+			require.Equal(t, 1, len(justifications))
+			assert.Equal(t, uint32(1), justifications[0].DealerIndex)
+			assert.Equal(t, uint32(2), justifications[0].ComplainerIndex)
+
+			return justifications
+		},
+	)
+	checkDKGResults(t, results, thresh, []uint32{1, 2, 3, 4, 5})
+}
+
+func TestDKGParticipant_UnansweredComplaintShouldDisqualifyDealer(t *testing.T) {
+	t.Parallel()
+
+	thresh := uint32(3)
+	participants := createDKGParticipants(t, 5, thresh, &inMemoryTransport{network: newInMemoryNetwork(5)})
+
+	results := runDKGPhases(
+		t,
+		participants,
+		func(deals []*threshold.Deal) {
+			deals[3].EncryptedShares[0] = nil
+		},
+		func(justifications []*threshold.Justification) []*threshold.Justification {
+			return nil
+		},
+	)
+	checkDKGResults(t, results, thresh, []uint32{1, 2, 3, 5})
+}
+
+func TestDKGParticipant_InvalidJustificationShouldDisqualifyDealer(t *testing.T) {
+	t.Parallel()
+
+	thresh := uint32(3)
+	participants := createDKGParticipants(t, 5, thresh, &inMemoryTransport{network: newInMemoryNetwork(5)})
+
+	results := runDKGPhases(
+		t,
+		participants,
+		func(deals []*threshold.Deal) {
+			deals[4].EncryptedShares[2] = deals[4].EncryptedShares[3]
+		},
+		func(justifications []*threshold.Justification) []*threshold.Justification {
+			require.Equal(t, 1, len(justifications))
+			wrongShare, _ := mcl.NewScalar().MarshalBinary()
+			justifications[0].Share = wrongShare
+
+			return justifications
+		},
+	)
+	checkDKGResults(t, results, thresh, []uint32{1, 2, 3, 4})
+}
+
+func TestDKGParticipant_MalformedDealShouldDisqualifyDealer(t *testing.T) {
+	t.Parallel()
+
+	thresh := uint32(3)
+	participants := createDKGParticipants(t, 5, thresh, &inMemoryTransport{network: newInMemoryNetwork(5)})
+
+	results := runDKGPhases(
+		t,
+		participants,
+		func(deals []*threshold.Deal) {
+			deals[1].Commitments = deals[1].Commitments[:2]
+		},
+		func(justifications []*threshold.Justification) []*threshold.Justification {
+			assert.Equal(t, 0, len(justifications))
+
+			return justifications
+		},
+	)
+	checkDKGResults(t, results, thresh, []uint32{1, 3, 4, 5})
+}
+
+func createBLSPublicKey(t *testing.T, point *mcl.PointG2) crypto.PublicKey {
+	pubKeyBytes, err := point.MarshalBinary()
+	require.Nil(t, err)
+
+	pubKey, err := signing.NewKeyGenerator(mcl.NewSuiteBLS12()).PublicKeyFromByteArray(pubKeyBytes)
+	require.Nil(t, err)
+
+	return pubKey
+}
+
+func assertEqualPoints(t *testing.T, expected *mcl.PointG2, actual *mcl.PointG2) {
+	isEqual, err := expected.Equal(actual)
+	require.Nil(t, err)
+	assert.True(t, isEqual)
+}
@@ -0,0 +1,21 @@
+package threshold
+
+import (
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+func NewRandomPolynomial(secret crypto.Scalar, degree uint32) ([]crypto.Scalar, error) {
+	return newRandomPolynomial(secret, degree)
+}
+
+func EvaluatePolynomial(coefficients []crypto.Scalar, index uint32) (crypto.Scalar, error) {
+	return polynomial(coefficients).evaluate(index)
+}
+
+func LagrangeCoefficientsAtZero(indexes []uint32) ([]crypto.Scalar, error) {
+	return lagrangeCoefficientsAtZero(indexes)
+}
+
+func CommitPolynomial(coefficients []crypto.Scalar) ([]crypto.Point, error) {
+	return polynomial(coefficients).commit()
+}
@@ -0,0 +1,172 @@
+package threshold
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+)
+
+// polynomial holds the coefficients of a polynomial over the BLS12-381 scalar field, starting with the free term
+type polynomial []crypto.Scalar
+
+// newRandomPolynomial creates a polynomial of the given degree that has the secret as free term
+// and random values for the other coefficients
+func newRandomPolynomial(secret crypto.Scalar, degree uint32) (polynomial, error) {
+	if check.IfNil(secret) {
+		return nil, crypto.ErrNilParam
+	}
+
+	coefficients := make(polynomial, degree+1)
+	coefficients[0] = secret.Clone()
+	for i := uint32(1); i <= degree; i++ {
+		coefficients[i] = mcl.NewScalar()
+	}
+
+	return coefficients, nil
+}
+
+// evaluate returns the value of the polynomial in the point x = index, using Horner's method
+func (p polynomial) evaluate(index uint32) (crypto.Scalar, error) {
+	x := scalarFromIndex(index)
+	result := p[len(p)-1].Clone()
+
+	var err error
+	for i := len(p) - 2; i >= 0; i-- {
+		result, err = result.Mul(x)
+		if err != nil {
+			return nil, err
+		}
+		result, err = result.Add(p[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// commit returns the Feldman commitments of the polynomial, as the coefficients multiplied by the G2 generator
+func (p polynomial) commit() ([]crypto.Point, error) {
+	commitments := make([]crypto.Point, 0, len(p))
+	for _, coefficient := range p {
+		commitment, err := mulGeneratorG2(coefficient)
+		if err != nil {
+			return nil, err
+		}
+
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments, nil
+}
+
+// mulGeneratorG2 returns the G2 generator multiplied by the secret scalar, with the precomputed generator table, so
+// that no secret dependent table lookup is done
+func mulGeneratorG2(secret crypto.Scalar) (crypto.Point, error) {
+	scalar, ok := secret.(*mcl.Scalar)
+	if !ok {
+		return nil, crypto.ErrInvalidScalar
+	}
+
+	return mcl.GeneratorTableG2().Mul(scalar)
+}
+
+// evaluateCommitments returns the value of the committed polynomial in the point x = index, multiplied by the
+// G2 generator, so that a share can be checked against the commitments without knowing the polynomial
+func evaluateCommitments(commitments []crypto.Point, index uint32) (crypto.Point, error) {
+	if len(commitments) == 0 {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	x := scalarFromIndex(index)
+	result := commitments[len(commitments)-1].Clone()
+
+	var err error
+	for i := len(commitments) - 2; i >= 0; i-- {
+		result, err = result.Mul(x)
+		if err != nil {
+			return nil, err
+		}
+		result, err = result.Add(commitments[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// verifyShare checks that share*G2 matches the committed polynomial evaluated in the point x = index
+func verifyShare(share crypto.Scalar, index uint32, commitments []crypto.Point) bool {
+	expected, err := evaluateCommitments(commitments, index)
+	if err != nil {
+		return false
+	}
+
+	actual, err := mulGeneratorG2(share)
+	if err != nil {
+		return false
+	}
+
+	isEqual, err := actual.Equal(expected)
+
+	return err == nil && isEqual
+}
+
+// lagrangeCoefficientsAtZero computes, for the given distinct non-zero indexes, the Lagrange basis polynomials
+// in the point x = 0: l_i = prod_{j != i} x_j / (x_j - x_i)
+func lagrangeCoefficientsAtZero(indexes []uint32) ([]crypto.Scalar, error) {
+	xs := make([]crypto.Scalar, len(indexes))
+	seen := make(map[uint32]struct{}, len(indexes))
+	for i, index := range indexes {
+		if index == 0 {
+			return nil, crypto.ErrInvalidShareIndex
+		}
+		_, found := seen[index]
+		if found {
+			return nil, crypto.ErrDuplicatedShareIndex
+		}
+
+		seen[index] = struct{}{}
+		xs[i] = scalarFromIndex(index)
+	}
+
+	coefficients := make([]crypto.Scalar, len(xs))
+	for i := range xs {
+		numerator := mcl.NewScalar().One()
+		denominator := mcl.NewScalar().One()
+		for j := range xs {
+			if i == j {
+				continue
+			}
+
+			diff, err := xs[j].Sub(xs[i])
+			if err != nil {
+				return nil, err
+			}
+			numerator, err = numerator.Mul(xs[j])
+			if err != nil {
+				return nil, err
+			}
+			denominator, err = denominator.Mul(diff)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		coefficient, err := numerator.Div(denominator)
+		if err != nil {
+			return nil, err
+		}
+		coefficients[i] = coefficient
+	}
+
+	return coefficients, nil
+}
+
+func scalarFromIndex(index uint32) crypto.Scalar {
+	scalar := mcl.NewScalar()
+	scalar.SetInt64(int64(index))
+
+	return scalar
+}
@@ -0,0 +1,100 @@
+package threshold_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/threshold"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRandomPolynomial(t *testing.T) {
+	t.Parallel()
+
+	coefficients, err := threshold.NewRandomPolynomial(nil, 2)
+	assert.Equal(t, crypto.ErrNilParam, err)
+	assert.Nil(t, coefficients)
+
+	secret := mcl.NewScalar()
+	coefficients, err = threshold.NewRandomPolynomial(secret, 2)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(coefficients))
+
+	eq, _ := coefficients[0].Equal(secret)
+	assert.True(t, eq)
+}
+
+func TestEvaluatePolynomial(t *testing.T) {
+	t.Parallel()
+
+	// p(x) = 3 + 2x + 5x^2
+	coefficients := make([]crypto.Scalar, 0, 3)
+	for _, value := range []int64{3, 2, 5} {
+		scalar := mcl.NewScalar()
+		scalar.SetInt64(value)
+		coefficients = append(coefficients, scalar)
+	}
+
+	for index, value := range map[uint32]int64{0: 3, 1: 10, 2: 27, 7: 262} {
+		result, err := threshold.EvaluatePolynomial(coefficients, index)
+		require.Nil(t, err)
+
+		expected := mcl.NewScalar()
+		expected.SetInt64(value)
+		eq, _ := result.Equal(expected)
+		assert.True(t, eq)
+	}
+}
+
+func TestCommitPolynomial(t *testing.T) {
+	t.Parallel()
+
+	coefficients, _ := threshold.NewRandomPolynomial(mcl.NewScalar(), 3)
+	commitments, err := threshold.CommitPolynomial(coefficients)
+	require.Nil(t, err)
+	require.Len(t, commitments, len(coefficients))
+
+	for i, coefficient := range coefficients {
+		expected, _ := mcl.NewPointG2().Mul(coefficient)
+		eq, _ := commitments[i].Equal(expected)
+		assert.True(t, eq)
+	}
+
+	_, err = threshold.CommitPolynomial([]crypto.Scalar{&mcl.Scalar{}})
+	assert.Equal(t, crypto.ErrNilParam, err)
+}
+
+func TestLagrangeCoefficientsAtZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero index should err", func(t *testing.T) {
+		coefficients, err := threshold.LagrangeCoefficientsAtZero([]uint32{1, 0, 3})
+		assert.Equal(t, crypto.ErrInvalidShareIndex, err)
+		assert.Nil(t, coefficients)
+	})
+	t.Run("duplicated index should err", func(t *testing.T) {
+		coefficients, err := threshold.LagrangeCoefficientsAtZero([]uint32{1, 3, 3})
+		assert.Equal(t, crypto.ErrDuplicatedShareIndex, err)
+		assert.Nil(t, coefficients)
+	})
+	t.Run("should interpolate the free term", func(t *testing.T) {
+		secret := mcl.NewScalar()
+		poly, _ := threshold.NewRandomPolynomial(secret, 3)
+		indexes := []uint32{9, 2, 5, 11}
+
+		coefficients, err := threshold.LagrangeCoefficientsAtZero(indexes)
+		require.Nil(t, err)
+
+		recovered := mcl.NewScalar().Zero()
+		for i, index := range indexes {
+			value, _ := threshold.EvaluatePolynomial(poly, index)
+			term, _ := value.Mul(coefficients[i])
+			recovered, _ = recovered.Add(term)
+		}
+
+		eq, _ := recovered.Equal(secret)
+		assert.True(t, eq)
+	})
+}
@@ -0,0 +1,384 @@
+package threshold
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+)
+
+const (
+	// ReshareDealsTopic is the transport topic used for the resharing deals
+	ReshareDealsTopic = "reshare_deals"
+	// ReshareComplaintsTopic is the transport topic used for the resharing complaints
+	ReshareComplaintsTopic = "reshare_complaints"
+	// ReshareJustificationsTopic is the transport topic used for the resharing justifications
+	ReshareJustificationsTopic = "reshare_justifications"
+)
+
+// ArgsResharingParticipant holds the arguments needed to create a resharing participant
+type ArgsResharingParticipant struct {
+	// SessionID must be unique for every run of the protocol, such as the epoch of the new sharing. It is bound to
+	// all the messages and shares, so they can not be replayed in another run
+	SessionID []byte
+	// IdentityKey is the ed25519 identity key of the participant
+	IdentityKey crypto.PrivateKey
+	// OldCommittee holds the ordered identity public keys of the current share holders
+	OldCommittee []crypto.PublicKey
+	// OldThreshold is the threshold of the current sharing
+	OldThreshold uint32
+	// OldShare is the current share of the participant. It is ignored if the participant is not in the old committee
+	OldShare *mcl.Scalar
+	// OldPublicShares[i] is the public key of the current share with index i+1
+	OldPublicShares []*mcl.PointG2
+	// GroupPublicKey is the public key of the group, that is preserved by the resharing
+	GroupPublicKey *mcl.PointG2
+	// NewCommittee holds the ordered identity public keys of the new share holders
+	NewCommittee []crypto.PublicKey
+	// NewThreshold is the threshold of the new sharing
+	NewThreshold uint32
+	// Transport is the communication layer between the participants
+	Transport crypto.DKGTransport
+}
+
+// ResharingParticipant moves a threshold BLS key from an old committee to a new one, possibly with a different
+// threshold, without reconstructing the group private key and without changing the group public key.
+// Every old share holder i deals its share s_i with a random polynomial g_i of degree newThreshold-1, g_i(0) = s_i.
+// The commitment of g_i(0) must match the known public key of the old share, so a dealer can not change the secret.
+// After the complaint and justification rounds, every new holder j combines the shares received from the first
+// oldThreshold qualified dealers Q: s'_j = sum_{i in Q} l_i * g_i(j), where l_i are the Lagrange coefficients of Q in 0.
+// The new shares lie on a fresh random polynomial with the same free term, so the old shares can not be combined
+// with the new ones. A participant can be part of the old committee, of the new one or of both of them.
+type ResharingParticipant struct {
+	args      ArgsResharingParticipant
+	oldIndex  uint32
+	newIndex  uint32
+	messenger *messenger
+	phase     dkgPhase
+	dealer    *dealer
+	receiver  *receiver
+}
+
+// NewResharingParticipant creates a participant of a resharing protocol
+func NewResharingParticipant(args ArgsResharingParticipant) (*ResharingParticipant, error) {
+	oldIndex, newIndex, err := checkResharingArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	rp := &ResharingParticipant{
+		args:     args,
+		oldIndex: oldIndex,
+		newIndex: newIndex,
+		messenger: &messenger{
+			sessionID: args.SessionID,
+			transport: args.Transport,
+			signer:    &singlesig.Ed25519Signer{},
+		},
+		phase: phaseDeal,
+	}
+
+	if newIndex > 0 {
+		rp.receiver = newReceiver(args.SessionID, newIndex, args.IdentityKey, args.OldCommittee, len(args.NewCommittee), args.NewThreshold, args.OldPublicShares)
+	}
+
+	return rp, nil
+}
+
+// NewRefreshParticipant creates a participant that refreshes the shares of its committee, keeping the same
+// threshold and the same group public key. After the refresh, the shares leaked before it become useless,
+// as they can not be combined with the refreshed ones
+func NewRefreshParticipant(
+	sessionID []byte,
+	identityKey crypto.PrivateKey,
+	committee []crypto.PublicKey,
+	threshold uint32,
+	share *mcl.Scalar,
+	publicShares []*mcl.PointG2,
+	groupPublicKey *mcl.PointG2,
+	transport crypto.DKGTransport,
+) (*ResharingParticipant, error) {
+	return NewResharingParticipant(ArgsResharingParticipant{
+		SessionID:       sessionID,
+		IdentityKey:     identityKey,
+		OldCommittee:    committee,
+		OldThreshold:    threshold,
+		OldShare:        share,
+		OldPublicShares: publicShares,
+		GroupPublicKey:  groupPublicKey,
+		NewCommittee:    committee,
+		NewThreshold:    threshold,
+		Transport:       transport,
+	})
+}
+
+func checkResharingArgs(args ArgsResharingParticipant) (uint32, uint32, error) {
+	if len(args.SessionID) == 0 {
+		return 0, 0, crypto.ErrNilSessionID
+	}
+	if check.IfNil(args.IdentityKey) {
+		return 0, 0, crypto.ErrNilPrivateKey
+	}
+	if len(args.OldCommittee) == 0 || len(args.NewCommittee) == 0 {
+		return 0, 0, crypto.ErrNilPublicKeys
+	}
+	if args.OldThreshold == 0 || int(args.OldThreshold) > len(args.OldCommittee) {
+		return 0, 0, crypto.ErrInvalidThreshold
+	}
+	if args.NewThreshold == 0 || int(args.NewThreshold) > len(args.NewCommittee) {
+		return 0, 0, crypto.ErrInvalidThreshold
+	}
+	if len(args.OldPublicShares) != len(args.OldCommittee) {
+		return 0, 0, crypto.ErrInvalidParam
+	}
+	for _, publicShare := range args.OldPublicShares {
+		if check.IfNil(publicShare) {
+			return 0, 0, crypto.ErrNilPublicKey
+		}
+	}
+	if check.IfNil(args.GroupPublicKey) {
+		return 0, 0, crypto.ErrNilPublicKey
+	}
+	if check.IfNil(args.Transport) {
+		return 0, 0, crypto.ErrNilDKGTransport
+	}
+
+	ownPubKey := args.IdentityKey.GeneratePublic()
+	oldIndex, err := findParticipantIndex(ownPubKey, args.OldCommittee)
+	if err != nil {
+		return 0, 0, err
+	}
+	newIndex, err := findParticipantIndex(ownPubKey, args.NewCommittee)
+	if err != nil {
+		return 0, 0, err
+	}
+	if oldIndex == 0 && newIndex == 0 {
+		return 0, 0, crypto.ErrParticipantNotFound
+	}
+	if oldIndex > 0 && !isOwnShare(args.OldShare, args.OldPublicShares[oldIndex-1]) {
+		return 0, 0, crypto.ErrInvalidPrivateKey
+	}
+
+	return oldIndex, newIndex, nil
+}
+
+func isOwnShare(share *mcl.Scalar, publicShare *mcl.PointG2) bool {
+	if check.IfNil(share) {
+		return false
+	}
+
+	computedPublicShare, err := mulGeneratorG2(share)
+	if err != nil {
+		return false
+	}
+
+	isEqual, err := computedPublicShare.Equal(publicShare)
+
+	return err == nil && isEqual
+}
+
+// OldIndex returns the index of the participant in the old committee, or 0 if it is not part of it
+func (rp *ResharingParticipant) OldIndex() uint32 {
+	return rp.oldIndex
+}
+
+// NewIndex returns the index of the participant in the new committee, or 0 if it is not part of it
+func (rp *ResharingParticipant) NewIndex() uint32 {
+	return rp.newIndex
+}
+
+// Run executes all the phases of the protocol, exchanging the messages through the transport. The participants
+// that are only part of the old committee get a nil result
+func (rp *ResharingParticipant) Run() (*DKGResult, error) {
+	deal, err := rp.Deal()
+	if err != nil {
+		return nil, err
+	}
+	if rp.dealer != nil {
+		err = rp.messenger.broadcast(ReshareDealsTopic, rp.oldIndex, rp.args.IdentityKey, deal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	deals := make([]*Deal, 0)
+	if rp.receiver != nil {
+		deals, err = rp.messenger.receiveDeals(ReshareDealsTopic, rp.args.OldCommittee, rp.oldIndex)
+		if err != nil {
+			return nil, err
+		}
+		if deal != nil {
+			deals = append(deals, deal)
+		}
+	}
+
+	complaints, err := rp.ProcessDeals(deals)
+	if err != nil {
+		return nil, err
+	}
+	if rp.receiver != nil {
+		err = rp.messenger.broadcast(ReshareComplaintsTopic, rp.newIndex, rp.args.IdentityKey, complaints)
+		if err != nil {
+			return nil, err
+		}
+	}
+	receivedComplaints, err := rp.messenger.receiveComplaints(ReshareComplaintsTopic, rp.args.NewCommittee, rp.newIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	justifications, err := rp.ProcessComplaints(append(receivedComplaints, complaints...))
+	if err != nil {
+		return nil, err
+	}
+	if rp.dealer != nil {
+		err = rp.messenger.broadcast(ReshareJustificationsTopic, rp.oldIndex, rp.args.IdentityKey, justifications)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	receivedJustifications := make([]*Justification, 0)
+	if rp.receiver != nil {
+		receivedJustifications, err = rp.messenger.receiveJustifications(ReshareJustificationsTopic, rp.args.OldCommittee, rp.oldIndex)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = rp.ProcessJustifications(append(receivedJustifications, justifications...))
+	if err != nil {
+		return nil, err
+	}
+	if rp.receiver == nil {
+		return nil, nil
+	}
+
+	return rp.Finalize()
+}
+
+// Deal returns the deal of the old share of the participant for the new committee, or nil if the participant
+// is not part of the old committee
+func (rp *ResharingParticipant) Deal() (*Deal, error) {
+	if rp.phase != phaseDeal {
+		return nil, crypto.ErrWrongDKGPhase
+	}
+
+	var deal *Deal
+	if rp.oldIndex > 0 {
+		d, err := newDealer(rp.args.SessionID, rp.oldIndex, rp.args.IdentityKey, rp.args.NewCommittee, rp.args.OldShare, rp.args.NewThreshold)
+		if err != nil {
+			return nil, err
+		}
+
+		deal, err = d.deal()
+		if err != nil {
+			return nil, err
+		}
+		rp.dealer = d
+	}
+
+	rp.phase = phaseProcessDeals
+
+	return deal, nil
+}
+
+// ProcessDeals processes the deals of the old committee and returns the complaints of the participant against the
+// dealers that sent an invalid share. Deals that do not preserve the old share of their dealer are disqualified
+func (rp *ResharingParticipant) ProcessDeals(deals []*Deal) ([]*Complaint, error) {
+	if rp.phase != phaseProcessDeals {
+		return nil, crypto.ErrWrongDKGPhase
+	}
+
+	complaints := make([]*Complaint, 0)
+	if rp.receiver != nil {
+		complaints = rp.receiver.processDeals(deals)
+	}
+	rp.phase = phaseProcessComplaints
+
+	return complaints, nil
+}
+
+// ProcessComplaints records the complaints of the new committee and returns the justifications of the participant
+// for the complaints against it
+func (rp *ResharingParticipant) ProcessComplaints(complaints []*Complaint) ([]*Justification, error) {
+	if rp.phase != phaseProcessComplaints {
+		return nil, crypto.ErrWrongDKGPhase
+	}
+
+	justifications := make([]*Justification, 0)
+	if rp.receiver != nil {
+		rp.receiver.processComplaints(complaints)
+	}
+	if rp.dealer != nil {
+		var err error
+		justifications, err = rp.dealer.justify(complaints)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rp.phase = phaseProcessJustifications
+
+	return justifications, nil
+}
+
+// ProcessJustifications checks the justifications of the old committee. The dealers with invalid justifications
+// or with unanswered complaints are disqualified
+func (rp *ResharingParticipant) ProcessJustifications(justifications []*Justification) error {
+	if rp.phase != phaseProcessJustifications {
+		return crypto.ErrWrongDKGPhase
+	}
+
+	if rp.receiver != nil {
+		rp.receiver.processJustifications(justifications)
+	}
+	rp.phase = phaseFinalize
+
+	return nil
+}
+
+// Finalize computes the new share of the participant and the public keys of all the new shares. The group public
+// key is checked to be the same as before the resharing
+func (rp *ResharingParticipant) Finalize() (*DKGResult, error) {
+	if rp.phase != phaseFinalize || rp.receiver == nil {
+		return nil, crypto.ErrWrongDKGPhase
+	}
+
+	qualified := rp.receiver.qualifiedDealers()
+	if uint32(len(qualified)) < rp.args.OldThreshold {
+		return nil, crypto.ErrNotEnoughQualifiedDealers
+	}
+
+	// any oldThreshold qualified dealers can recover the group secret, so the first ones are used
+	qualified = qualified[:rp.args.OldThreshold]
+	weights, err := lagrangeCoefficientsAtZero(qualified)
+	if err != nil {
+		return nil, err
+	}
+
+	share, groupPublicKey, publicShares, err := rp.receiver.combine(qualified, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	isSameGroupKey, err := groupPublicKey.Equal(rp.args.GroupPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !isSameGroupKey {
+		return nil, crypto.ErrInvalidPublicKey
+	}
+
+	return &DKGResult{
+		Index:          rp.newIndex,
+		Share:          share,
+		GroupPublicKey: groupPublicKey,
+		PublicShares:   publicShares,
+		Qualified:      qualified,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rp *ResharingParticipant) IsInterfaceNil() bool {
+	return rp == nil
+}
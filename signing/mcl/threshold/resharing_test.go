@@ -0,0 +1,378 @@
+package threshold_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/threshold"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testReshareSessionID = []byte("epoch 2")
+
+type committee struct {
+	privKeys []crypto.PrivateKey
+	pubKeys  []crypto.PublicKey
+	results  []*threshold.DKGResult
+}
+
+func createCommitteeWithKey(t *testing.T, n int, thresh uint32) *committee {
+	privKeys, pubKeys := createIdentities(n)
+	transport := &inMemoryTransport{network: newInMemoryNetwork(n)}
+	participants := make([]*threshold.DKGParticipant, 0, n)
+	for i := 0; i < n; i++ {
+		participant, err := threshold.NewDKGParticipant(testSessionID, privKeys[i], pubKeys, thresh, transport)
+		require.Nil(t, err)
+		participants = append(participants, participant)
+	}
+
+	return &committee{
+		privKeys: privKeys,
+		pubKeys:  pubKeys,
+		results:  runDKG(t, participants),
+	}
+}
+
+func createResharingArgs(oldCommittee *committee, oldThresh uint32, newCommittee []crypto.PublicKey, newThresh uint32) threshold.ArgsResharingParticipant {
+	return threshold.ArgsResharingParticipant{
+		SessionID:       testReshareSessionID,
+		IdentityKey:     oldCommittee.privKeys[0],
+		OldCommittee:    oldCommittee.pubKeys,
+		OldThreshold:    oldThresh,
+		OldShare:        oldCommittee.results[0].Share,
+		OldPublicShares: oldCommittee.results[0].PublicShares,
+		GroupPublicKey:  oldCommittee.results[0].GroupPublicKey,
+		NewCommittee:    newCommittee,
+		NewThreshold:    newThresh,
+		Transport:       &inMemoryTransport{network: newInMemoryNetwork(1)},
+	}
+}
+
+func runResharing(t *testing.T, participants []*threshold.ResharingParticipant) []*threshold.DKGResult {
+	results := make([]*threshold.DKGResult, len(participants))
+	wg := sync.WaitGroup{}
+	wg.Add(len(participants))
+	for i := range participants {
+		go func(idx int) {
+			defer wg.Done()
+
+			result, err := participants[idx].Run()
+			assert.Nil(t, err)
+			results[idx] = result
+		}(i)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func recoverGroupSignature(t *testing.T, thresh uint32, numShares int, shares []*threshold.KeyShare, msg []byte) []byte {
+	thresholdSigner, err := threshold.NewBlsThresholdSigner(thresh, uint32(numShares))
+	require.Nil(t, err)
+
+	sigShares := make([]*threshold.SignatureShare, 0, len(shares))
+	for _, share := range shares {
+		sigShare, errSign := thresholdSigner.SignShare(share, msg)
+		require.Nil(t, errSign)
+		sigShares = append(sigShares, sigShare)
+	}
+
+	groupSig, err := thresholdSigner.RecoverSignature(sigShares)
+	require.Nil(t, err)
+
+	return groupSig
+}
+
+func TestNewResharingParticipant(t *testing.T) {
+	t.Parallel()
+
+	oldCommittee := createCommitteeWithKey(t, 4, 3)
+	_, newPubKeys := createIdentities(5)
+
+	t.Run("empty session ID should err", func(t *testing.T) {
+		args := createResharingArgs(oldCommittee, 3, newPubKeys, 3)
+		args.SessionID = nil
+		participant, err := threshold.NewResharingParticipant(args)
+		assert.Equal(t, crypto.ErrNilSessionID, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("nil identity key should err", func(t *testing.T) {
+		args := createResharingArgs(oldCommittee, 3, newPubKeys, 3)
+		args.IdentityKey = nil
+		participant, err := threshold.NewResharingParticipant(args)
+		assert.Equal(t, crypto.ErrNilPrivateKey, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("empty new committee should err", func(t *testing.T) {
+		args := createResharingArgs(oldCommittee, 3, nil, 3)
+		participant, err := threshold.NewResharingParticipant(args)
+		assert.Equal(t, crypto.ErrNilPublicKeys, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("invalid thresholds should err", func(t *testing.T) {
+		participant, err := threshold.NewResharingParticipant(createResharingArgs(oldCommittee, 5, newPubKeys, 3))
+		assert.Equal(t, crypto.ErrInvalidThreshold, err)
+		assert.True(t, check.IfNil(participant))
+
+		participant, err = threshold.NewResharingParticipant(createResharingArgs(oldCommittee, 3, newPubKeys, 0))
+		assert.Equal(t, crypto.ErrInvalidThreshold, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("wrong number of old public shares should err", func(t *testing.T) {
+		args := createResharingArgs(oldCommittee, 3, newPubKeys, 3)
+		args.OldPublicShares = args.OldPublicShares[1:]
+		participant, err := threshold.NewResharingParticipant(args)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("nil group public key should err", func(t *testing.T) {
+		args := createResharingArgs(oldCommittee, 3, newPubKeys, 3)
+		args.GroupPublicKey = nil
+		participant, err := threshold.NewResharingParticipant(args)
+		assert.Equal(t, crypto.ErrNilPublicKey, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("nil transport should err", func(t *testing.T) {
+		args := createResharingArgs(oldCommittee, 3, newPubKeys, 3)
+		args.Transport = nil
+		participant, err := threshold.NewResharingParticipant(args)
+		assert.Equal(t, crypto.ErrNilDKGTransport, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("not in any committee should err", func(t *testing.T) {
+		args := createResharingArgs(oldCommittee, 3, newPubKeys, 3)
+		outsider, _ := createIdentities(1)
+		args.IdentityKey = outsider[0]
+		participant, err := threshold.NewResharingParticipant(args)
+		assert.Equal(t, crypto.ErrParticipantNotFound, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("old share not matching the public share should err", func(t *testing.T) {
+		args := createResharingArgs(oldCommittee, 3, newPubKeys, 3)
+		args.OldShare = oldCommittee.results[1].Share
+		participant, err := threshold.NewResharingParticipant(args)
+		assert.Equal(t, crypto.ErrInvalidPrivateKey, err)
+		assert.True(t, check.IfNil(participant))
+	})
+	t.Run("should work", func(t *testing.T) {
+		participant, err := threshold.NewResharingParticipant(createResharingArgs(oldCommittee, 3, newPubKeys, 3))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(participant))
+		assert.Equal(t, uint32(1), participant.OldIndex())
+		assert.Equal(t, uint32(0), participant.NewIndex())
+	})
+}
+
+func TestResharingParticipant_ReshareToNewCommittee(t *testing.T) {
+	t.Parallel()
+
+	oldThresh := uint32(3)
+	newThresh := uint32(4)
+	oldCommittee := createCommitteeWithKey(t, 5, oldThresh)
+
+	// the last two members of the old committee are also part of the new one
+	newPrivKeys, newPubKeys := createIdentities(5)
+	newPrivKeys = append(append([]crypto.PrivateKey{}, oldCommittee.privKeys[3:]...), newPrivKeys...)
+	newPubKeys = append(append([]crypto.PublicKey{}, oldCommittee.pubKeys[3:]...), newPubKeys...)
+
+	network := newInMemoryNetwork(0)
+	network.numBroadcastersPerTopic[threshold.ReshareDealsTopic] = len(oldCommittee.pubKeys)
+	network.numBroadcastersPerTopic[threshold.ReshareComplaintsTopic] = len(newPubKeys)
+	network.numBroadcastersPerTopic[threshold.ReshareJustificationsTopic] = len(oldCommittee.pubKeys)
+	transport := &inMemoryTransport{network: network}
+
+	participants := make([]*threshold.ResharingParticipant, 0)
+	identities := append(append([]crypto.PrivateKey{}, oldCommittee.privKeys[:3]...), newPrivKeys...)
+	for i, identity := range identities {
+		args := createResharingArgs(oldCommittee, oldThresh, newPubKeys, newThresh)
+		args.IdentityKey = identity
+		args.Transport = transport
+		if i < len(oldCommittee.results) {
+			args.OldShare = oldCommittee.results[i].Share
+		}
+
+		participant, err := threshold.NewResharingParticipant(args)
+		require.Nil(t, err)
+		participants = append(participants, participant)
+	}
+
+	results := runResharing(t, participants)
+	for _, result := range results[:3] {
+		assert.Nil(t, result)
+	}
+	newResults := results[3:]
+	checkDKGResults(t, newResults, newThresh, []uint32{1, 2, 3})
+	assertEqualPoints(t, oldCommittee.results[0].GroupPublicKey, newResults[0].GroupPublicKey)
+	for i, result := range newResults {
+		assert.Equal(t, uint32(i+1), result.Index)
+	}
+
+	// old shares can not be combined with new shares
+	msg := []byte("message")
+	oldShare, _ := oldCommittee.results[0].KeyShare()
+	mixedShares := []*threshold.KeyShare{oldShare}
+	for _, result := range newResults[1:newThresh] {
+		newShare, _ := result.KeyShare()
+		mixedShares = append(mixedShares, newShare)
+	}
+	groupSig := recoverGroupSignature(t, newThresh, len(newResults), mixedShares, msg)
+
+	err := singlesig.NewBlsSigner().Verify(createBLSPublicKey(t, oldCommittee.results[0].GroupPublicKey), msg, groupSig)
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestResharingParticipant_Refresh(t *testing.T) {
+	t.Parallel()
+
+	n := 4
+	thresh := uint32(2)
+	oldCommittee := createCommitteeWithKey(t, n, thresh)
+
+	transport := &inMemoryTransport{network: newInMemoryNetwork(n)}
+	participants := make([]*threshold.ResharingParticipant, 0, n)
+	for i := 0; i < n; i++ {
+		participant, err := threshold.NewRefreshParticipant(
+			testReshareSessionID,
+			oldCommittee.privKeys[i],
+			oldCommittee.pubKeys,
+			thresh,
+			oldCommittee.results[i].Share,
+			oldCommittee.results[i].PublicShares,
+			oldCommittee.results[i].GroupPublicKey,
+			transport,
+		)
+		require.Nil(t, err)
+		assert.Equal(t, participant.OldIndex(), participant.NewIndex())
+		participants = append(participants, participant)
+	}
+
+	results := runResharing(t, participants)
+	checkDKGResults(t, results, thresh, []uint32{1, 2})
+	assertEqualPoints(t, oldCommittee.results[0].GroupPublicKey, results[0].GroupPublicKey)
+	for i, result := range results {
+		isSameShare, _ := oldCommittee.results[i].Share.Equal(result.Share)
+		assert.False(t, isSameShare)
+	}
+
+	// a leaked old share is useless together with a refreshed share
+	msg := []byte("message")
+	oldShare, _ := oldCommittee.results[0].KeyShare()
+	newShare, _ := results[1].KeyShare()
+	groupSig := recoverGroupSignature(t, thresh, n, []*threshold.KeyShare{oldShare, newShare}, msg)
+
+	err := singlesig.NewBlsSigner().Verify(createBLSPublicKey(t, results[0].GroupPublicKey), msg, groupSig)
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+}
+
+func TestResharingParticipant_ReplayedDealOfAnotherSessionShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	n := 3
+	thresh := uint32(2)
+	oldCommittee := createCommitteeWithKey(t, n, thresh)
+	createParticipants := func(sessionID []byte, network *inMemoryNetwork) []*threshold.ResharingParticipant {
+		participants := make([]*threshold.ResharingParticipant, 0, n)
+		for i := 0; i < n; i++ {
+			participant, err := threshold.NewRefreshParticipant(
+				sessionID,
+				oldCommittee.privKeys[i],
+				oldCommittee.pubKeys,
+				thresh,
+				oldCommittee.results[i].Share,
+				oldCommittee.results[i].PublicShares,
+				oldCommittee.results[i].GroupPublicKey,
+				&inMemoryTransport{network: network},
+			)
+			require.Nil(t, err)
+			participants = append(participants, participant)
+		}
+
+		return participants
+	}
+
+	oldNetwork := newInMemoryNetwork(n)
+	_ = runResharing(t, createParticipants([]byte("epoch 1 refresh"), oldNetwork))
+
+	// the old deal of dealer 1 is replayed in the new session, which would disqualify it for dealing twice
+	var replayedDeal []byte
+	for _, message := range oldNetwork.messages[threshold.ReshareDealsTopic] {
+		envelope := struct {
+			SenderIndex uint32 `json:"senderIndex"`
+		}{}
+		_ = json.Unmarshal(message, &envelope)
+		if envelope.SenderIndex == 1 {
+			replayedDeal = message
+		}
+	}
+	require.NotNil(t, replayedDeal)
+
+	network := newInMemoryNetwork(n)
+	network.messages[threshold.ReshareDealsTopic] = [][]byte{replayedDeal}
+	network.numBroadcastersPerTopic[threshold.ReshareDealsTopic] = n + 1
+
+	results := runResharing(t, createParticipants(testReshareSessionID, network))
+	checkDKGResults(t, results, thresh, []uint32{1, 2})
+	assertEqualPoints(t, oldCommittee.results[0].GroupPublicKey, results[0].GroupPublicKey)
+}
+
+func TestResharingParticipant_DealerChangingItsShareShouldBeDisqualified(t *testing.T) {
+	t.Parallel()
+
+	thresh := uint32(2)
+	oldCommittee := createCommitteeWithKey(t, 3, thresh)
+	transport := &inMemoryTransport{network: newInMemoryNetwork(3)}
+
+	participants := make([]*threshold.ResharingParticipant, 0, 3)
+	for i := 0; i < 3; i++ {
+		participant, err := threshold.NewRefreshParticipant(
+			testReshareSessionID,
+			oldCommittee.privKeys[i],
+			oldCommittee.pubKeys,
+			thresh,
+			oldCommittee.results[i].Share,
+			oldCommittee.results[i].PublicShares,
+			oldCommittee.results[i].GroupPublicKey,
+			transport,
+		)
+		require.Nil(t, err)
+		participants = append(participants, participant)
+	}
+
+	deals := make([]*threshold.Deal, 0, 3)
+	for _, participant := range participants {
+		deal, err := participant.Deal()
+		require.Nil(t, err)
+		deals = append(deals, deal)
+	}
+
+	// dealer 1 deals a different secret, with consistent commitments
+	otherSecretCommitment, _ := mcl.NewPointG2().Mul(mcl.NewScalar())
+	deals[0].Commitments[0], _ = otherSecretCommitment.MarshalBinary()
+
+	results := make([]*threshold.DKGResult, 0, 3)
+	for _, participant := range participants {
+		complaints, err := participant.ProcessDeals(deals)
+		require.Nil(t, err)
+		assert.Equal(t, 0, len(complaints))
+
+		justifications, err := participant.ProcessComplaints(nil)
+		require.Nil(t, err)
+		assert.Equal(t, 0, len(justifications))
+
+		err = participant.ProcessJustifications(nil)
+		require.Nil(t, err)
+
+		result, err := participant.Finalize()
+		require.Nil(t, err)
+		results = append(results, result)
+	}
+
+	checkDKGResults(t, results, thresh, []uint32{2, 3})
+	assertEqualPoints(t, oldCommittee.results[0].GroupPublicKey, results[0].GroupPublicKey)
+}
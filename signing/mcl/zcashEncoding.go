@@ -0,0 +1,366 @@
+package mcl
+
+import (
+	"bytes"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+/*
+The ZCash serialization of the BLS12-381 points is the one used by the IETF BLS signature draft, by Ethereum and by
+most of the other BLS12-381 libraries. It is not the native serialization of the herumi library, which writes the
+field elements in little endian order.
+
+The field elements are written in big endian order and, for Fp2 elements, the imaginary part comes first. The three
+most significant bits of the first byte are flags:
+  - 0x80: the point is compressed, only the x coordinate is written, otherwise x is followed by y
+  - 0x40: the point is the point at infinity, all the other bits are 0
+  - 0x20: set for compressed points when y is the lexicographically largest of y and -y
+*/
+
+const (
+	// ZCashCompressedG1Len is the length of a compressed G1 point in the ZCash serialization
+	ZCashCompressedG1Len = 48
+	// ZCashCompressedG2Len is the length of a compressed G2 point in the ZCash serialization
+	ZCashCompressedG2Len = 96
+	// ZCashUncompressedG1Len is the length of an uncompressed G1 point in the ZCash serialization
+	ZCashUncompressedG1Len = 96
+	// ZCashUncompressedG2Len is the length of an uncompressed G2 point in the ZCash serialization
+	ZCashUncompressedG2Len = 192
+
+	fpByteLen              = 48
+	zcashCompressedFlag    = 0x80
+	zcashInfinityFlag      = 0x40
+	zcashSignFlag          = 0x20
+	zcashFlagsMask         = zcashCompressedFlag | zcashInfinityFlag | zcashSignFlag
+	bls12381CurveConstantB = 4
+)
+
+// G1ToZCashCompressed serializes the G1 point in the ZCash compressed format
+func G1ToZCashCompressed(point *bls.G1) []byte {
+	result := make([]byte, ZCashCompressedG1Len)
+	if point.IsZero() {
+		result[0] = zcashCompressedFlag | zcashInfinityFlag
+		return result
+	}
+
+	affine := &bls.G1{}
+	bls.G1Normalize(affine, point)
+
+	copy(result, fpToBigEndian(&affine.X))
+	result[0] |= zcashCompressedFlag
+	if isFpLexicographicallyLargest(&affine.Y) {
+		result[0] |= zcashSignFlag
+	}
+
+	return result
+}
+
+// G1FromZCashCompressed deserializes a G1 point from the ZCash compressed format. The point is checked to be on the
+// curve and in the G1 subgroup. The point at infinity is accepted, the caller should reject it if it is not valid
+// in its context
+func G1FromZCashCompressed(data []byte) (*bls.G1, error) {
+	if len(data) != ZCashCompressedG1Len {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	isInfinity, isLargest, err := decodeZCashFlags(data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	point := &bls.G1{}
+	if isInfinity {
+		point.Clear()
+		return point, nil
+	}
+
+	xBytes := append([]byte{}, data...)
+	xBytes[0] &^= zcashFlagsMask
+	err = fpFromBigEndian(&point.X, xBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// y^2 = x^3 + 4
+	ySquare := &bls.Fp{}
+	bls.FpSqr(ySquare, &point.X)
+	bls.FpMul(ySquare, ySquare, &point.X)
+	b := &bls.Fp{}
+	b.SetInt64(bls12381CurveConstantB)
+	bls.FpAdd(ySquare, ySquare, b)
+	if !bls.FpSquareRoot(&point.Y, ySquare) {
+		return nil, crypto.ErrInvalidPoint
+	}
+	if isFpLexicographicallyLargest(&point.Y) != isLargest {
+		bls.FpNeg(&point.Y, &point.Y)
+	}
+	point.Z.SetInt64(1)
+
+	return validateG1(point)
+}
+
+// G2ToZCashCompressed serializes the G2 point in the ZCash compressed format
+func G2ToZCashCompressed(point *bls.G2) []byte {
+	result := make([]byte, ZCashCompressedG2Len)
+	if point.IsZero() {
+		result[0] = zcashCompressedFlag | zcashInfinityFlag
+		return result
+	}
+
+	affine := &bls.G2{}
+	bls.G2Normalize(affine, point)
+
+	copy(result, fpToBigEndian(&affine.X.D[1]))
+	copy(result[fpByteLen:], fpToBigEndian(&affine.X.D[0]))
+	result[0] |= zcashCompressedFlag
+	if isFp2LexicographicallyLargest(&affine.Y) {
+		result[0] |= zcashSignFlag
+	}
+
+	return result
+}
+
+// G2FromZCashCompressed deserializes a G2 point from the ZCash compressed format. The point is checked to be on the
+// curve and in the G2 subgroup. The point at infinity is accepted, the caller should reject it if it is not valid
+// in its context
+func G2FromZCashCompressed(data []byte) (*bls.G2, error) {
+	if len(data) != ZCashCompressedG2Len {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	isInfinity, isLargest, err := decodeZCashFlags(data, true)
+	if err != nil {
+		return nil, err
+	}
+
+	point := &bls.G2{}
+	if isInfinity {
+		point.Clear()
+		return point, nil
+	}
+
+	xBytes := append([]byte{}, data...)
+	xBytes[0] &^= zcashFlagsMask
+	err = fpFromBigEndian(&point.X.D[1], xBytes[:fpByteLen])
+	if err != nil {
+		return nil, err
+	}
+	err = fpFromBigEndian(&point.X.D[0], xBytes[fpByteLen:])
+	if err != nil {
+		return nil, err
+	}
+
+	// y^2 = x^3 + 4(1+i)
+	ySquare := &bls.Fp2{}
+	bls.Fp2Sqr(ySquare, &point.X)
+	bls.Fp2Mul(ySquare, ySquare, &point.X)
+	b := &bls.Fp2{}
+	b.D[0].SetInt64(bls12381CurveConstantB)
+	b.D[1].SetInt64(bls12381CurveConstantB)
+	bls.Fp2Add(ySquare, ySquare, b)
+	if !bls.Fp2SquareRoot(&point.Y, ySquare) {
+		return nil, crypto.ErrInvalidPoint
+	}
+	if isFp2LexicographicallyLargest(&point.Y) != isLargest {
+		bls.Fp2Neg(&point.Y, &point.Y)
+	}
+	point.Z.D[0].SetInt64(1)
+
+	return validateG2(point)
+}
+
+// G1ToZCashUncompressed serializes the G1 point in the ZCash uncompressed format
+func G1ToZCashUncompressed(point *bls.G1) []byte {
+	result := make([]byte, ZCashUncompressedG1Len)
+	if point.IsZero() {
+		result[0] = zcashInfinityFlag
+		return result
+	}
+
+	affine := &bls.G1{}
+	bls.G1Normalize(affine, point)
+
+	copy(result, fpToBigEndian(&affine.X))
+	copy(result[fpByteLen:], fpToBigEndian(&affine.Y))
+
+	return result
+}
+
+// G1FromZCashUncompressed deserializes a G1 point from the ZCash uncompressed format. The point is checked to be on
+// the curve and in the G1 subgroup. The point at infinity is accepted
+func G1FromZCashUncompressed(data []byte) (*bls.G1, error) {
+	if len(data) != ZCashUncompressedG1Len {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	isInfinity, _, err := decodeZCashFlags(data, false)
+	if err != nil {
+		return nil, err
+	}
+
+	point := &bls.G1{}
+	if isInfinity {
+		point.Clear()
+		return point, nil
+	}
+
+	err = fpFromBigEndian(&point.X, data[:fpByteLen])
+	if err != nil {
+		return nil, err
+	}
+	err = fpFromBigEndian(&point.Y, data[fpByteLen:])
+	if err != nil {
+		return nil, err
+	}
+	point.Z.SetInt64(1)
+
+	return validateG1(point)
+}
+
+// G2ToZCashUncompressed serializes the G2 point in the ZCash uncompressed format
+func G2ToZCashUncompressed(point *bls.G2) []byte {
+	result := make([]byte, ZCashUncompressedG2Len)
+	if point.IsZero() {
+		result[0] = zcashInfinityFlag
+		return result
+	}
+
+	affine := &bls.G2{}
+	bls.G2Normalize(affine, point)
+
+	copy(result, fpToBigEndian(&affine.X.D[1]))
+	copy(result[fpByteLen:], fpToBigEndian(&affine.X.D[0]))
+	copy(result[2*fpByteLen:], fpToBigEndian(&affine.Y.D[1]))
+	copy(result[3*fpByteLen:], fpToBigEndian(&affine.Y.D[0]))
+
+	return result
+}
+
+// G2FromZCashUncompressed deserializes a G2 point from the ZCash uncompressed format. The point is checked to be on
+// the curve and in the G2 subgroup. The point at infinity is accepted
+func G2FromZCashUncompressed(data []byte) (*bls.G2, error) {
+	if len(data) != ZCashUncompressedG2Len {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	isInfinity, _, err := decodeZCashFlags(data, false)
+	if err != nil {
+		return nil, err
+	}
+
+	point := &bls.G2{}
+	if isInfinity {
+		point.Clear()
+		return point, nil
+	}
+
+	coordinates := []*bls.Fp{&point.X.D[1], &point.X.D[0], &point.Y.D[1], &point.Y.D[0]}
+	for i, coordinate := range coordinates {
+		err = fpFromBigEndian(coordinate, data[i*fpByteLen:(i+1)*fpByteLen])
+		if err != nil {
+			return nil, err
+		}
+	}
+	point.Z.D[0].SetInt64(1)
+
+	return validateG2(point)
+}
+
+// decodeZCashFlags checks the flags of a compressed or uncompressed point and returns the infinity and the sign flags
+func decodeZCashFlags(data []byte, isCompressed bool) (isInfinity bool, isLargest bool, err error) {
+	flags := data[0] & zcashFlagsMask
+	if (flags&zcashCompressedFlag != 0) != isCompressed {
+		return false, false, crypto.ErrInvalidPoint
+	}
+
+	isInfinity = flags&zcashInfinityFlag != 0
+	isLargest = flags&zcashSignFlag != 0
+	if isLargest && !isCompressed {
+		return false, false, crypto.ErrInvalidPoint
+	}
+	if !isInfinity {
+		return false, isLargest, nil
+	}
+
+	if isLargest || data[0] != flags || !isAllZero(data[1:]) {
+		return false, false, crypto.ErrInvalidPoint
+	}
+
+	return true, false, nil
+}
+
+// validateG1 checks that the decoded point is on the curve and in the G1 subgroup
+func validateG1(point *bls.G1) (*bls.G1, error) {
+	if !point.IsValid() || !point.IsValidOrder() {
+		return nil, crypto.ErrInvalidPoint
+	}
+
+	return point, nil
+}
+
+// validateG2 checks that the decoded point is on the curve and in the G2 subgroup
+func validateG2(point *bls.G2) (*bls.G2, error) {
+	if !point.IsValid() || !point.IsValidOrder() {
+		return nil, crypto.ErrInvalidPoint
+	}
+
+	return point, nil
+}
+
+func isAllZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fpToBigEndian returns the big endian representation of the field element, the herumi one being little endian
+func fpToBigEndian(fp *bls.Fp) []byte {
+	return reverseBytes(fp.Serialize())
+}
+
+// fpFromBigEndian sets the field element from its big endian representation, which has to be lower than the modulus
+func fpFromBigEndian(fp *bls.Fp, data []byte) error {
+	if len(data) != fpByteLen {
+		return crypto.ErrInvalidParam
+	}
+
+	err := fp.Deserialize(reverseBytes(data))
+	if err != nil {
+		return crypto.ErrInvalidPoint
+	}
+
+	return nil
+}
+
+// isFpLexicographicallyLargest returns true if the field element is greater than its opposite, when both are
+// taken as integers in [0, p-1]
+func isFpLexicographicallyLargest(fp *bls.Fp) bool {
+	negated := &bls.Fp{}
+	bls.FpNeg(negated, fp)
+
+	return bytes.Compare(fpToBigEndian(fp), fpToBigEndian(negated)) > 0
+}
+
+// isFp2LexicographicallyLargest compares the imaginary parts first and the real parts if the imaginary parts are 0
+func isFp2LexicographicallyLargest(fp2 *bls.Fp2) bool {
+	if !fp2.D[1].IsZero() {
+		return isFpLexicographicallyLargest(&fp2.D[1])
+	}
+
+	return isFpLexicographicallyLargest(&fp2.D[0])
+}
+
+func reverseBytes(data []byte) []byte {
+	result := make([]byte, len(data))
+	for i := range data {
+		result[len(data)-1-i] = data[i]
+	}
+
+	return result
+}
@@ -0,0 +1,244 @@
+package mcl
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	zcashCompressedGeneratorG1 = "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb"
+	zcashCompressedGeneratorG2 = "93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e" +
+		"024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8"
+)
+
+func TestG1ToZCashCompressed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generator", func(t *testing.T) {
+		encoded := G1ToZCashCompressed(NewPointG1().G1)
+		require.Equal(t, zcashCompressedGeneratorG1, hex.EncodeToString(encoded))
+	})
+	t.Run("point at infinity", func(t *testing.T) {
+		zero := &bls.G1{}
+		zero.Clear()
+
+		expected := make([]byte, ZCashCompressedG1Len)
+		expected[0] = 0xc0
+		require.Equal(t, expected, G1ToZCashCompressed(zero))
+	})
+}
+
+func TestG1FromZCashCompressed(t *testing.T) {
+	t.Parallel()
+
+	generatorBytes, _ := hex.DecodeString(zcashCompressedGeneratorG1)
+
+	t.Run("wrong length should err", func(t *testing.T) {
+		point, err := G1FromZCashCompressed(generatorBytes[1:])
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("missing compression flag should err", func(t *testing.T) {
+		data := append([]byte{}, generatorBytes...)
+		data[0] &^= 0x80
+
+		point, err := G1FromZCashCompressed(data)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("infinity with other bits set should err", func(t *testing.T) {
+		data := make([]byte, ZCashCompressedG1Len)
+		data[0] = 0xc0
+		data[ZCashCompressedG1Len-1] = 1
+
+		point, err := G1FromZCashCompressed(data)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("x not on curve should err", func(t *testing.T) {
+		data := make([]byte, ZCashCompressedG1Len)
+		data[0] = 0x80
+		data[ZCashCompressedG1Len-1] = 1
+
+		point, err := G1FromZCashCompressed(data)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("x greater than the modulus should err", func(t *testing.T) {
+		data := make([]byte, ZCashCompressedG1Len)
+		for i := range data {
+			data[i] = 0xff
+		}
+		data[0] = 0x9f
+
+		point, err := G1FromZCashCompressed(data)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("point at infinity", func(t *testing.T) {
+		data := make([]byte, ZCashCompressedG1Len)
+		data[0] = 0xc0
+
+		point, err := G1FromZCashCompressed(data)
+		require.Nil(t, err)
+		require.True(t, point.IsZero())
+	})
+	t.Run("round trip should work", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			_, point := NewSuiteBLS12MinPubKey().CreateKeyPair()
+			g1 := point.(*PointG1).G1
+
+			decoded, err := G1FromZCashCompressed(G1ToZCashCompressed(g1))
+			require.Nil(t, err)
+			require.True(t, decoded.IsEqual(g1))
+		}
+	})
+}
+
+func TestG2ToZCashCompressed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generator", func(t *testing.T) {
+		encoded := G2ToZCashCompressed(NewStandardGeneratorG2())
+		require.Equal(t, zcashCompressedGeneratorG2, hex.EncodeToString(encoded))
+	})
+	t.Run("point at infinity", func(t *testing.T) {
+		zero := &bls.G2{}
+		zero.Clear()
+
+		expected := make([]byte, ZCashCompressedG2Len)
+		expected[0] = 0xc0
+		require.Equal(t, expected, G2ToZCashCompressed(zero))
+	})
+}
+
+func TestG2FromZCashCompressed(t *testing.T) {
+	t.Parallel()
+
+	generatorBytes, _ := hex.DecodeString(zcashCompressedGeneratorG2)
+
+	t.Run("wrong length should err", func(t *testing.T) {
+		point, err := G2FromZCashCompressed(generatorBytes[1:])
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("opposite sign should give the negated point", func(t *testing.T) {
+		data := append([]byte{}, generatorBytes...)
+		data[0] ^= 0x20
+
+		point, err := G2FromZCashCompressed(data)
+		require.Nil(t, err)
+
+		expected := &bls.G2{}
+		bls.G2Neg(expected, NewStandardGeneratorG2())
+		require.True(t, point.IsEqual(expected))
+	})
+	t.Run("point at infinity", func(t *testing.T) {
+		data := make([]byte, ZCashCompressedG2Len)
+		data[0] = 0xc0
+
+		point, err := G2FromZCashCompressed(data)
+		require.Nil(t, err)
+		require.True(t, point.IsZero())
+	})
+	t.Run("round trip should work", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			_, point := NewSuiteBLS12().CreateKeyPair()
+			g2 := point.(*PointG2).G2
+
+			decoded, err := G2FromZCashCompressed(G2ToZCashCompressed(g2))
+			require.Nil(t, err)
+			require.True(t, decoded.IsEqual(g2))
+		}
+	})
+}
+
+func TestG1ZCashUncompressed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generator", func(t *testing.T) {
+		encoded := G1ToZCashUncompressed(NewPointG1().G1)
+		expected := "17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb" +
+			"08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1"
+		require.Equal(t, expected, hex.EncodeToString(encoded))
+	})
+	t.Run("wrong length should err", func(t *testing.T) {
+		point, err := G1FromZCashUncompressed(make([]byte, ZCashCompressedG1Len))
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("compression or sign flag should err", func(t *testing.T) {
+		for _, flag := range []byte{0x80, 0x20} {
+			data := G1ToZCashUncompressed(NewPointG1().G1)
+			data[0] |= flag
+
+			point, err := G1FromZCashUncompressed(data)
+			require.Nil(t, point)
+			require.Equal(t, crypto.ErrInvalidPoint, err)
+		}
+	})
+	t.Run("point not on curve should err", func(t *testing.T) {
+		data := G1ToZCashUncompressed(NewPointG1().G1)
+		data[ZCashUncompressedG1Len-1] ^= 0x01
+
+		point, err := G1FromZCashUncompressed(data)
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidPoint, err)
+	})
+	t.Run("point at infinity", func(t *testing.T) {
+		zero := &bls.G1{}
+		zero.Clear()
+		data := G1ToZCashUncompressed(zero)
+		require.Equal(t, byte(0x40), data[0])
+
+		point, err := G1FromZCashUncompressed(data)
+		require.Nil(t, err)
+		require.True(t, point.IsZero())
+	})
+	t.Run("round trip should work", func(t *testing.T) {
+		_, point := NewSuiteBLS12MinPubKey().CreateKeyPair()
+		g1 := point.(*PointG1).G1
+
+		decoded, err := G1FromZCashUncompressed(G1ToZCashUncompressed(g1))
+		require.Nil(t, err)
+		require.True(t, decoded.IsEqual(g1))
+	})
+}
+
+func TestG2ZCashUncompressed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generator", func(t *testing.T) {
+		encoded := G2ToZCashUncompressed(NewStandardGeneratorG2())
+		expected := zcashCompressedGeneratorG2 +
+			"0606c4a02ea734cc32acd2b02bc28b99cb3e287e85a763af267492ab572e99ab3f370d275cec1da1aaa9075ff05f79be" +
+			"0ce5d527727d6e118cc9cdc6da2e351aadfd9baa8cbdd3a76d429a695160d12c923ac9cc3baca289e193548608b82801"
+		expected = "13" + expected[2:]
+		require.Equal(t, expected, hex.EncodeToString(encoded))
+	})
+	t.Run("wrong length should err", func(t *testing.T) {
+		point, err := G2FromZCashUncompressed(make([]byte, ZCashCompressedG2Len))
+		require.Nil(t, point)
+		require.Equal(t, crypto.ErrInvalidParam, err)
+	})
+	t.Run("point at infinity", func(t *testing.T) {
+		zero := &bls.G2{}
+		zero.Clear()
+
+		point, err := G2FromZCashUncompressed(G2ToZCashUncompressed(zero))
+		require.Nil(t, err)
+		require.True(t, point.IsZero())
+	})
+	t.Run("round trip should work", func(t *testing.T) {
+		_, point := NewSuiteBLS12().CreateKeyPair()
+		g2 := point.(*PointG2).G2
+
+		decoded, err := G2FromZCashUncompressed(G2ToZCashUncompressed(g2))
+		require.Nil(t, err)
+		require.True(t, decoded.IsEqual(g2))
+	})
+}
@@ -0,0 +1,78 @@
+package multisig
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+var _ crypto.AggregateSigner = (*blsAggregateSigner)(nil)
+
+type blsAggregateSigner struct {
+	keyGen   crypto.KeyGenerator
+	llSigner crypto.LowLevelAggregateSignerBLS
+}
+
+// NewBLSAggregateSigner creates a new BLS signer that aggregates signatures over distinct messages
+func NewBLSAggregateSigner(
+	llSigner crypto.LowLevelAggregateSignerBLS,
+	keyGen crypto.KeyGenerator,
+) (*blsAggregateSigner, error) {
+	if check.IfNil(llSigner) {
+		return nil, crypto.ErrNilLowLevelSigner
+	}
+	if check.IfNil(keyGen) {
+		return nil, crypto.ErrNilKeyGenerator
+	}
+	return &blsAggregateSigner{
+		keyGen:   keyGen,
+		llSigner: llSigner,
+	}, nil
+}
+
+// CreateSignatureShare returns a BLS single signature over the message with the given private key
+func (bas *blsAggregateSigner) CreateSignatureShare(privateKeyBytes []byte, message []byte) ([]byte, error) {
+	privateKey, err := convertBytesToPrivateKey(privateKeyBytes, bas.keyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	return bas.llSigner.SignShare(privateKey, message)
+}
+
+// VerifySignatureShare verifies the single signature share with the given message and public key
+func (bas *blsAggregateSigner) VerifySignatureShare(publicKey []byte, message []byte, sig []byte) error {
+	if sig == nil {
+		return crypto.ErrNilSignature
+	}
+
+	pubKey, err := convertBytesToPubKey(publicKey, bas.keyGen)
+	if err != nil {
+		return err
+	}
+
+	return bas.llSigner.VerifySigShare(pubKey, message, sig)
+}
+
+// AggregateSigs aggregates the received signatures into one signature
+func (bas *blsAggregateSigner) AggregateSigs(signatures [][]byte) ([]byte, error) {
+	return bas.llSigner.AggregateSignatures(bas.keyGen.Suite(), signatures)
+}
+
+// AggregateVerify verifies the aggregated signature validity, where messages[i] was signed by pubKeysSigners[i]
+func (bas *blsAggregateSigner) AggregateVerify(pubKeysSigners [][]byte, messages [][]byte, aggSig []byte) error {
+	if len(pubKeysSigners) != len(messages) {
+		return crypto.ErrInvalidParam
+	}
+
+	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bas.keyGen)
+	if err != nil {
+		return err
+	}
+
+	return bas.llSigner.AggregateVerify(bas.keyGen.Suite(), pubKeys, messages, aggSig)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bas *blsAggregateSigner) IsInterfaceNil() bool {
+	return bas == nil
+}
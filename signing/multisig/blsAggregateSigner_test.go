@@ -0,0 +1,98 @@
+package multisig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	llsig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDistinctMessagesSigSharesBLS(
+	nbSigs int,
+	llSigner crypto.LowLevelAggregateSignerBLS,
+) (aggSigner crypto.AggregateSigner, pubKeys [][]byte, messages [][]byte, sigShares [][]byte) {
+	privKeys, pubKeys, kg := generateMultiSigParamsBLSWithPrivateKeys(nbSigs)
+	aggSigner, _ = multisig.NewBLSAggregateSigner(llSigner, kg)
+
+	messages = make([][]byte, nbSigs)
+	sigShares = make([][]byte, nbSigs)
+	for i := 0; i < nbSigs; i++ {
+		messages[i] = []byte(fmt.Sprintf("message %d", i))
+		sigShares[i], _ = aggSigner.CreateSignatureShare(privKeys[i], messages[i])
+	}
+
+	return aggSigner, pubKeys, messages, sigShares
+}
+
+func TestNewBLSAggregateSigner(t *testing.T) {
+	t.Parallel()
+
+	_, kg := generateMultiSigParamsBLS(1)
+
+	t.Run("nil low level signer should err", func(t *testing.T) {
+		aggSigner, err := multisig.NewBLSAggregateSigner(nil, kg)
+
+		assert.Nil(t, aggSigner)
+		assert.Equal(t, crypto.ErrNilLowLevelSigner, err)
+	})
+	t.Run("nil key generator should err", func(t *testing.T) {
+		aggSigner, err := multisig.NewBLSAggregateSigner(&llsig.BlsAggregateSigner{}, nil)
+
+		assert.Nil(t, aggSigner)
+		assert.Equal(t, crypto.ErrNilKeyGenerator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		aggSigner, err := multisig.NewBLSAggregateSigner(&llsig.BlsAggregateSigner{}, kg)
+
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(aggSigner))
+	})
+}
+
+func TestBLSAggregateSigner_VerifySignatureShare(t *testing.T) {
+	t.Parallel()
+
+	aggSigner, pubKeys, messages, sigShares := createDistinctMessagesSigSharesBLS(2, &llsig.BlsAggregateSigner{})
+
+	err := aggSigner.VerifySignatureShare(pubKeys[0], messages[0], nil)
+	assert.Equal(t, crypto.ErrNilSignature, err)
+
+	err = aggSigner.VerifySignatureShare(pubKeys[0], messages[0], sigShares[1])
+	assert.Equal(t, crypto.ErrSigNotValid, err)
+
+	err = aggSigner.VerifySignatureShare(pubKeys[0], messages[0], sigShares[0])
+	assert.Nil(t, err)
+}
+
+func TestBLSAggregateSigner_AggregateVerify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("distinct messages", func(t *testing.T) {
+		aggSigner, pubKeys, messages, sigShares := createDistinctMessagesSigSharesBLS(10, &llsig.BlsAggregateSigner{})
+		aggSig, err := aggSigner.AggregateSigs(sigShares)
+		require.Nil(t, err)
+
+		err = aggSigner.AggregateVerify(pubKeys, messages, aggSig)
+		assert.Nil(t, err)
+
+		err = aggSigner.AggregateVerify(pubKeys, messages[1:], aggSig)
+		assert.Equal(t, crypto.ErrInvalidParam, err)
+
+		err = aggSigner.AggregateVerify(pubKeys[1:], messages[1:], aggSig)
+		assert.Equal(t, crypto.ErrAggSigNotValid, err)
+	})
+	t.Run("augmented messages", func(t *testing.T) {
+		llSigner := &llsig.BlsAggregateSigner{MessageAugmentation: true}
+		aggSigner, pubKeys, messages, sigShares := createDistinctMessagesSigSharesBLS(10, llSigner)
+		aggSig, err := aggSigner.AggregateSigs(sigShares)
+		require.Nil(t, err)
+
+		err = aggSigner.AggregateVerify(pubKeys, messages, aggSig)
+		assert.Nil(t, err)
+	})
+}
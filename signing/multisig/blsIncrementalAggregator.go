@@ -0,0 +1,204 @@
+package multisig
+
+import (
+	"sync"
+
+	"github.com/herumi/bls-go-binary/bls"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+)
+
+var _ crypto.IncrementalAggregator = (*blsIncrementalAggregator)(nil)
+
+type signatureShare struct {
+	pubKeyPoint *mcl.PointG2
+	sigPoint    *mcl.PointG1
+}
+
+// blsIncrementalAggregator keeps a running aggregation of BLS signature shares and of the matching public keys.
+// The aggregation is the plain sum of the shares, as in the KOSK scheme, so the final signature is the same as the
+// one produced by AggregateSigs with the KOSK low level signer. The modified BLS scheme can not be aggregated
+// incrementally, as its coefficients depend on the whole set of signers.
+type blsIncrementalAggregator struct {
+	mut       sync.RWMutex
+	keyGen    crypto.KeyGenerator
+	aggSig    *mcl.PointG1
+	aggPubKey *mcl.PointG2
+	shares    map[string]*signatureShare
+}
+
+// NewBLSIncrementalAggregator creates a new incremental aggregator of BLS signature shares
+func NewBLSIncrementalAggregator(keyGen crypto.KeyGenerator) (*blsIncrementalAggregator, error) {
+	if check.IfNil(keyGen) {
+		return nil, crypto.ErrNilKeyGenerator
+	}
+	if check.IfNil(keyGen.Suite()) {
+		return nil, crypto.ErrNilSuite
+	}
+	_, ok := keyGen.Suite().GetUnderlyingSuite().(*mcl.SuiteBLS12)
+	if !ok {
+		return nil, crypto.ErrInvalidSuite
+	}
+
+	return &blsIncrementalAggregator{
+		keyGen:    keyGen,
+		aggSig:    &mcl.PointG1{G1: &bls.G1{}},
+		aggPubKey: &mcl.PointG2{G2: &bls.G2{}},
+		shares:    make(map[string]*signatureShare),
+	}, nil
+}
+
+// Add adds the signature share of the given public key to the aggregation
+func (bia *blsIncrementalAggregator) Add(pubKey []byte, sigShare []byte) error {
+	if len(sigShare) == 0 {
+		return crypto.ErrNilSignature
+	}
+
+	pubKeyPoint, err := bia.pubKeyBytesToPoint(pubKey)
+	if err != nil {
+		return err
+	}
+
+	sigPoint := &mcl.PointG1{G1: &bls.G1{}}
+	err = sigPoint.UnmarshalBinary(sigShare)
+	if err != nil {
+		return err
+	}
+	if !singlesig.IsSigValidPoint(bls.CastToSign(sigPoint.G1)) {
+		return crypto.ErrBLSInvalidSignature
+	}
+
+	bia.mut.Lock()
+	defer bia.mut.Unlock()
+
+	_, found := bia.shares[string(pubKey)]
+	if found {
+		return crypto.ErrPubKeyAlreadyAggregated
+	}
+
+	err = bia.aggSig.AddAssign(sigPoint)
+	if err != nil {
+		return err
+	}
+	err = bia.aggPubKey.AddAssign(pubKeyPoint)
+	if err != nil {
+		return err
+	}
+
+	bia.shares[string(pubKey)] = &signatureShare{
+		pubKeyPoint: pubKeyPoint,
+		sigPoint:    sigPoint,
+	}
+
+	return nil
+}
+
+// Remove removes the signature share of the given public key from the aggregation
+func (bia *blsIncrementalAggregator) Remove(pubKey []byte) error {
+	if len(pubKey) == 0 {
+		return crypto.ErrEmptyPubKey
+	}
+
+	bia.mut.Lock()
+	defer bia.mut.Unlock()
+
+	share, found := bia.shares[string(pubKey)]
+	if !found {
+		return crypto.ErrPubKeyNotAggregated
+	}
+
+	err := bia.aggSig.SubAssign(share.sigPoint)
+	if err != nil {
+		return err
+	}
+	err = bia.aggPubKey.SubAssign(share.pubKeyPoint)
+	if err != nil {
+		return err
+	}
+
+	delete(bia.shares, string(pubKey))
+
+	return nil
+}
+
+// Has returns true if the signature share of the given public key is part of the aggregation
+func (bia *blsIncrementalAggregator) Has(pubKey []byte) bool {
+	bia.mut.RLock()
+	defer bia.mut.RUnlock()
+
+	_, found := bia.shares[string(pubKey)]
+
+	return found
+}
+
+// NumShares returns the number of aggregated signature shares
+func (bia *blsIncrementalAggregator) NumShares() int {
+	bia.mut.RLock()
+	defer bia.mut.RUnlock()
+
+	return len(bia.shares)
+}
+
+// AggregatedSig returns the aggregated signature of the current shares
+func (bia *blsIncrementalAggregator) AggregatedSig() ([]byte, error) {
+	bia.mut.RLock()
+	defer bia.mut.RUnlock()
+
+	if len(bia.shares) == 0 {
+		return nil, crypto.ErrNilSignaturesList
+	}
+
+	return bia.aggSig.MarshalBinary()
+}
+
+// AggregatedPubKey returns the aggregated public key of the current shares
+func (bia *blsIncrementalAggregator) AggregatedPubKey() ([]byte, error) {
+	bia.mut.RLock()
+	defer bia.mut.RUnlock()
+
+	if len(bia.shares) == 0 {
+		return nil, crypto.ErrNilPublicKeys
+	}
+
+	return bia.aggPubKey.MarshalBinary()
+}
+
+// Reset removes all the shares from the aggregation
+func (bia *blsIncrementalAggregator) Reset() {
+	bia.mut.Lock()
+	defer bia.mut.Unlock()
+
+	bia.aggSig.G1.Clear()
+	bia.aggPubKey.G2.Clear()
+	bia.shares = make(map[string]*signatureShare)
+}
+
+func (bia *blsIncrementalAggregator) pubKeyBytesToPoint(pubKeyBytes []byte) (*mcl.PointG2, error) {
+	if len(pubKeyBytes) == 0 {
+		return nil, crypto.ErrEmptyPubKey
+	}
+
+	err := bia.keyGen.CheckPublicKeyValid(pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := convertBytesToPubKey(pubKeyBytes, bia.keyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyPoint, ok := pubKey.Point().(*mcl.PointG2)
+	if !ok {
+		return nil, crypto.ErrInvalidPublicKey
+	}
+
+	return pubKeyPoint, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bia *blsIncrementalAggregator) IsInterfaceNil() bool {
+	return bia == nil
+}
@@ -0,0 +1,181 @@
+package multisig_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/mock"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	llsig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBLSIncrementalAggregator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil key generator should err", func(t *testing.T) {
+		aggregator, err := multisig.NewBLSIncrementalAggregator(nil)
+		assert.Equal(t, crypto.ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(aggregator))
+	})
+	t.Run("nil suite should err", func(t *testing.T) {
+		aggregator, err := multisig.NewBLSIncrementalAggregator(&mock.KeyGenMock{
+			SuiteMock: func() crypto.Suite {
+				return nil
+			},
+		})
+		assert.Equal(t, crypto.ErrNilSuite, err)
+		assert.True(t, check.IfNil(aggregator))
+	})
+	t.Run("not BLS suite should err", func(t *testing.T) {
+		aggregator, err := multisig.NewBLSIncrementalAggregator(signing.NewKeyGenerator(ed25519.NewEd25519()))
+		assert.Equal(t, crypto.ErrInvalidSuite, err)
+		assert.True(t, check.IfNil(aggregator))
+	})
+	t.Run("should work", func(t *testing.T) {
+		_, kg := generateMultiSigParamsBLS(1)
+		aggregator, err := multisig.NewBLSIncrementalAggregator(kg)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(aggregator))
+		assert.Equal(t, 0, aggregator.NumShares())
+	})
+}
+
+func TestBLSIncrementalAggregator_AddInvalidShareShouldErr(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	_, pubKeys, sigShares := createSigSharesBLS(2, msg, &llsig.BlsMultiSignerKOSK{})
+	_, kg := generateMultiSigParamsBLS(1)
+	aggregator, _ := multisig.NewBLSIncrementalAggregator(kg)
+
+	err := aggregator.Add(pubKeys[0], nil)
+	assert.Equal(t, crypto.ErrNilSignature, err)
+
+	err = aggregator.Add(nil, sigShares[0])
+	assert.Equal(t, crypto.ErrEmptyPubKey, err)
+
+	err = aggregator.Add([]byte("invalid public key"), sigShares[0])
+	assert.NotNil(t, err)
+
+	err = aggregator.Add(pubKeys[0], []byte("invalid signature"))
+	assert.NotNil(t, err)
+
+	err = aggregator.Add(pubKeys[0], sigShares[0])
+	assert.Nil(t, err)
+
+	err = aggregator.Add(pubKeys[0], sigShares[0])
+	assert.Equal(t, crypto.ErrPubKeyAlreadyAggregated, err)
+	assert.Equal(t, 1, aggregator.NumShares())
+}
+
+func TestBLSIncrementalAggregator_ShouldMatchKOSKAggregation(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	multiSigner, pubKeys, sigShares := createSigSharesBLS(10, msg, &llsig.BlsMultiSignerKOSK{})
+	_, kg := generateMultiSigParamsBLS(1)
+	aggregator, _ := multisig.NewBLSIncrementalAggregator(kg)
+
+	aggSig, err := aggregator.AggregatedSig()
+	assert.Equal(t, crypto.ErrNilSignaturesList, err)
+	assert.Nil(t, aggSig)
+	aggPubKey, err := aggregator.AggregatedPubKey()
+	assert.Equal(t, crypto.ErrNilPublicKeys, err)
+	assert.Nil(t, aggPubKey)
+
+	for i := range pubKeys {
+		err = aggregator.Add(pubKeys[i], sigShares[i])
+		require.Nil(t, err)
+
+		expectedAggSig, errAggregate := multiSigner.AggregateSigs(pubKeys[:i+1], sigShares[:i+1])
+		require.Nil(t, errAggregate)
+
+		aggSig, err = aggregator.AggregatedSig()
+		require.Nil(t, err)
+		assert.Equal(t, expectedAggSig, aggSig)
+		assert.Nil(t, multiSigner.VerifyAggregatedSig(pubKeys[:i+1], msg, aggSig))
+	}
+
+	aggPubKey, err = aggregator.AggregatedPubKey()
+	require.Nil(t, err)
+	expectedAggPubKey, err := multiSigner.(crypto.PubKeysAggregator).AggregatePubKeys(pubKeys)
+	require.Nil(t, err)
+	assert.Equal(t, expectedAggPubKey, aggPubKey)
+}
+
+func TestBLSIncrementalAggregator_Remove(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	multiSigner, pubKeys, sigShares := createSigSharesBLS(5, msg, &llsig.BlsMultiSignerKOSK{})
+	_, kg := generateMultiSigParamsBLS(1)
+	aggregator, _ := multisig.NewBLSIncrementalAggregator(kg)
+
+	for i := range pubKeys {
+		err := aggregator.Add(pubKeys[i], sigShares[i])
+		require.Nil(t, err)
+	}
+
+	err := aggregator.Remove(nil)
+	assert.Equal(t, crypto.ErrEmptyPubKey, err)
+
+	err = aggregator.Remove([]byte("not added"))
+	assert.Equal(t, crypto.ErrPubKeyNotAggregated, err)
+
+	err = aggregator.Remove(pubKeys[2])
+	require.Nil(t, err)
+	assert.False(t, aggregator.Has(pubKeys[2]))
+	assert.True(t, aggregator.Has(pubKeys[3]))
+	assert.Equal(t, 4, aggregator.NumShares())
+
+	remainingPubKeys := [][]byte{pubKeys[0], pubKeys[1], pubKeys[3], pubKeys[4]}
+	remainingSigShares := [][]byte{sigShares[0], sigShares[1], sigShares[3], sigShares[4]}
+	expectedAggSig, err := multiSigner.AggregateSigs(remainingPubKeys, remainingSigShares)
+	require.Nil(t, err)
+
+	aggSig, err := aggregator.AggregatedSig()
+	require.Nil(t, err)
+	assert.Equal(t, expectedAggSig, aggSig)
+
+	aggPubKey, err := aggregator.AggregatedPubKey()
+	require.Nil(t, err)
+	err = multiSigner.(crypto.PubKeysAggregator).VerifyAggregatedSigWithAggPubKey(aggPubKey, msg, aggSig)
+	assert.Nil(t, err)
+
+	aggregator.Reset()
+	assert.Equal(t, 0, aggregator.NumShares())
+	assert.False(t, aggregator.Has(pubKeys[0]))
+}
+
+func TestBLSIncrementalAggregator_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	multiSigner, pubKeys, sigShares := createSigSharesBLS(20, msg, &llsig.BlsMultiSignerKOSK{})
+	_, kg := generateMultiSigParamsBLS(1)
+	aggregator, _ := multisig.NewBLSIncrementalAggregator(kg)
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(pubKeys))
+	for i := range pubKeys {
+		go func(idx int) {
+			defer wg.Done()
+
+			errAdd := aggregator.Add(pubKeys[idx], sigShares[idx])
+			assert.Nil(t, errAdd)
+			_ = aggregator.NumShares()
+			_, _ = aggregator.AggregatedSig()
+		}(i)
+	}
+	wg.Wait()
+
+	aggSig, err := aggregator.AggregatedSig()
+	require.Nil(t, err)
+	assert.Nil(t, multiSigner.VerifyAggregatedSig(pubKeys, msg, aggSig))
+}
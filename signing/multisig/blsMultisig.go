@@ -3,13 +3,19 @@ package multisig
 import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
 )
 
 var _ crypto.MultiSigner = (*blsMultiSigner)(nil)
+var _ crypto.PubKeysAggregator = (*blsMultiSigner)(nil)
+var _ crypto.WeightedMultiSigner = (*blsMultiSigner)(nil)
+var _ crypto.FaultAttributionMultiSigner = (*blsMultiSigner)(nil)
 
 type blsMultiSigner struct {
 	keyGen   crypto.KeyGenerator
 	llSigner crypto.LowLevelSignerBLS
+	// signersKeyGen decodes the public keys of the signers, it is the key generator with the public keys cache, if set
+	signersKeyGen crypto.KeyGenerator
 }
 
 // NewBLSMultisig creates a new BLS multi-signer
@@ -24,11 +30,37 @@ func NewBLSMultisig(
 		return nil, crypto.ErrNilKeyGenerator
 	}
 	return &blsMultiSigner{
-		keyGen:   keyGen,
-		llSigner: llSigner,
+		keyGen:        keyGen,
+		llSigner:      llSigner,
+		signersKeyGen: keyGen,
 	}, nil
 }
 
+// NewBLSMultisigWithPublicKeysCache creates a new BLS multi-signer that decodes the public keys of the signers with a
+// key generator of the same suite as keyGen, keeping them in the given cache, so the public keys received again are
+// not decoded and validated again. The given key generator should not have a public keys cache of its own
+func NewBLSMultisigWithPublicKeysCache(
+	llSigner crypto.LowLevelSignerBLS,
+	keyGen crypto.KeyGenerator,
+	pubKeysCache *signing.PublicKeysCache,
+) (*blsMultiSigner, error) {
+	if check.IfNil(pubKeysCache) {
+		return nil, crypto.ErrNilPublicKeysCache
+	}
+
+	bms, err := NewBLSMultisig(llSigner, keyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	bms.signersKeyGen, err = signing.NewKeyGeneratorWithPublicKeysCache(keyGen.Suite(), pubKeysCache)
+	if err != nil {
+		return nil, err
+	}
+
+	return bms, nil
+}
+
 // CreateSignatureShare returns a BLS single signature over the message with the given private key
 func (bms *blsMultiSigner) CreateSignatureShare(privateKeyBytes []byte, message []byte) ([]byte, error) {
 	privateKey, err := convertBytesToPrivateKey(privateKeyBytes, bms.keyGen)
@@ -45,7 +77,7 @@ func (bms *blsMultiSigner) VerifySignatureShare(publicKey []byte, message []byte
 		return crypto.ErrNilSignature
 	}
 
-	pubKey, err := convertBytesToPubKey(publicKey, bms.keyGen)
+	pubKey, err := convertBytesToPubKey(publicKey, bms.signersKeyGen)
 	if err != nil {
 		return err
 	}
@@ -59,7 +91,7 @@ func (bms *blsMultiSigner) AggregateSigs(pubKeysSigners [][]byte, signatures [][
 		return nil, crypto.ErrInvalidParam
 	}
 
-	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bms.keyGen)
+	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bms.signersKeyGen)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +101,7 @@ func (bms *blsMultiSigner) AggregateSigs(pubKeysSigners [][]byte, signatures [][
 
 // VerifyAggregatedSig verifies the aggregated signature validity with respect to the aggregated public keys and given message
 func (bms *blsMultiSigner) VerifyAggregatedSig(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
-	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bms.keyGen)
+	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bms.signersKeyGen)
 	if err != nil {
 		return err
 	}
@@ -77,6 +109,105 @@ func (bms *blsMultiSigner) VerifyAggregatedSig(pubKeysSigners [][]byte, message
 	return bms.llSigner.VerifyAggregatedSig(bms.keyGen.Suite(), pubKeys, aggSig, message)
 }
 
+// AggregatePubKeys aggregates the public keys of the signers into one public key, that can later be used
+// instead of the list of public keys to verify the aggregated signature of the same signers
+func (bms *blsMultiSigner) AggregatePubKeys(pubKeysSigners [][]byte) ([]byte, error) {
+	pubKeysAggregator, ok := bms.llSigner.(crypto.LowLevelPubKeysAggregatorBLS)
+	if !ok {
+		return nil, crypto.ErrNotImplemented
+	}
+
+	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bms.signersKeyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	aggPubKey, err := pubKeysAggregator.AggregatePublicKeys(bms.keyGen.Suite(), pubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggPubKey.ToByteArray()
+}
+
+// VerifyAggregatedSigWithAggPubKey verifies the aggregated signature validity with respect to the aggregated public key
+// obtained with AggregatePubKeys and the given message
+func (bms *blsMultiSigner) VerifyAggregatedSigWithAggPubKey(aggPubKey []byte, message []byte, aggSig []byte) error {
+	pubKeysAggregator, ok := bms.llSigner.(crypto.LowLevelPubKeysAggregatorBLS)
+	if !ok {
+		return crypto.ErrNotImplemented
+	}
+
+	// the aggregated public key is decoded with the given key generator, not the one of the signers, so it is not put in
+	// the public keys cache and does not evict the public keys of the signers
+	pubKey, err := convertBytesToPubKey(aggPubKey, bms.keyGen)
+	if err != nil {
+		return err
+	}
+
+	return pubKeysAggregator.VerifyAggregatedSigWithAggregatedPubKey(bms.keyGen.Suite(), pubKey, aggSig, message)
+}
+
+// AggregateWeightedSigs aggregates the received signatures, each one scaled by the weight of the signer with the
+// public key on the same position, into one signature
+func (bms *blsMultiSigner) AggregateWeightedSigs(pubKeysSigners [][]byte, signatures [][]byte, weights []uint64) ([]byte, error) {
+	weightedSigner, ok := bms.llSigner.(crypto.LowLevelWeightedSignerBLS)
+	if !ok {
+		return nil, crypto.ErrNotImplemented
+	}
+	if len(pubKeysSigners) != len(signatures) || len(weights) != len(signatures) {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bms.signersKeyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	return weightedSigner.AggregateWeightedSignatures(bms.keyGen.Suite(), signatures, pubKeys, weights)
+}
+
+// VerifyWeightedAggregatedSig verifies the weighted aggregated signature with respect to the public keys and weights
+// of the signers and the given message. On success, it returns the total weight of the signers
+func (bms *blsMultiSigner) VerifyWeightedAggregatedSig(
+	pubKeysSigners [][]byte,
+	weights []uint64,
+	message []byte,
+	aggSig []byte,
+) (uint64, error) {
+	weightedSigner, ok := bms.llSigner.(crypto.LowLevelWeightedSignerBLS)
+	if !ok {
+		return 0, crypto.ErrNotImplemented
+	}
+
+	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bms.signersKeyGen)
+	if err != nil {
+		return 0, err
+	}
+
+	return weightedSigner.VerifyWeightedAggregatedSig(bms.keyGen.Suite(), pubKeys, weights, aggSig, message)
+}
+
+// AggregateAndVerifySigs aggregates the received signatures and verifies the aggregated signature over the message,
+// without verifying the signatures one by one. If the aggregated signature is not valid, the returned error is an
+// *crypto.InvalidSharesError holding the indexes of the invalid signatures
+func (bms *blsMultiSigner) AggregateAndVerifySigs(pubKeysSigners [][]byte, signatures [][]byte, message []byte) ([]byte, error) {
+	faultAttributionSigner, ok := bms.llSigner.(crypto.LowLevelFaultAttributionSignerBLS)
+	if !ok {
+		return nil, crypto.ErrNotImplemented
+	}
+	if len(pubKeysSigners) != len(signatures) {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	pubKeys, err := convertBytesToPubKeys(pubKeysSigners, bms.signersKeyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	return faultAttributionSigner.AggregateAndVerifySignatures(bms.keyGen.Suite(), signatures, pubKeys, message)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bms *blsMultiSigner) IsInterfaceNil() bool {
 	return bms == nil
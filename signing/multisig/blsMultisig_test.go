@@ -1,6 +1,7 @@
 package multisig_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -141,6 +142,90 @@ func TestNewBLSMultisig_OK(t *testing.T) {
 	})
 }
 
+func TestNewBLSMultisigWithPublicKeysCache(t *testing.T) {
+	t.Parallel()
+
+	llSigner := &llsig.BlsMultiSignerKOSK{}
+	_, kg := generateMultiSigParamsBLS(4)
+	cache, _ := signing.NewPublicKeysCache(4)
+
+	t.Run("nil public keys cache should err", func(t *testing.T) {
+		multiSig, err := multisig.NewBLSMultisigWithPublicKeysCache(llSigner, kg, nil)
+
+		assert.Nil(t, multiSig)
+		assert.Equal(t, crypto.ErrNilPublicKeysCache, err)
+	})
+	t.Run("nil key generator should err", func(t *testing.T) {
+		multiSig, err := multisig.NewBLSMultisigWithPublicKeysCache(llSigner, nil, cache)
+
+		assert.Nil(t, multiSig)
+		assert.Equal(t, crypto.ErrNilKeyGenerator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		multiSig, err := multisig.NewBLSMultisigWithPublicKeysCache(llSigner, kg, cache)
+
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(multiSig))
+	})
+}
+
+func TestBLSMultiSigner_WithPublicKeysCacheShouldWork(t *testing.T) {
+	t.Parallel()
+
+	numSigners := 5
+	msg := []byte("message")
+	hasher := &mock.HasherSpongeMock{}
+	llSigners := map[string]crypto.LowLevelSignerBLS{
+		"with rogue key prevention": &llsig.BlsMultiSigner{Hasher: hasher},
+		"with KOSK":                 &llsig.BlsMultiSignerKOSK{},
+	}
+
+	for name, llSigner := range llSigners {
+		llSigner := llSigner
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			privKeys, pubKeys, kg := generateMultiSigParamsBLSWithPrivateKeys(numSigners)
+			cache, _ := signing.NewPublicKeysCache(numSigners)
+			multiSigner, _ := multisig.NewBLSMultisigWithPublicKeysCache(llSigner, kg, cache)
+
+			sigShares := make([][]byte, numSigners)
+			for i := range privKeys {
+				sigShares[i], _ = multiSigner.CreateSignatureShare(privKeys[i], msg)
+				err := multiSigner.VerifySignatureShare(pubKeys[i], msg, sigShares[i])
+				require.Nil(t, err)
+			}
+			require.Equal(t, numSigners, cache.Len())
+			require.Equal(t, uint64(numSigners), cache.Metrics().Misses)
+
+			aggSig, err := multiSigner.AggregateSigs(pubKeys, sigShares)
+			require.Nil(t, err)
+			err = multiSigner.VerifyAggregatedSig(pubKeys, msg, aggSig)
+			require.Nil(t, err)
+
+			expectedMetrics := signing.PublicKeysCacheMetrics{
+				Hits:   uint64(2 * numSigners),
+				Misses: uint64(numSigners),
+				Size:   numSigners,
+			}
+			require.Equal(t, expectedMetrics, cache.Metrics())
+
+			err = multiSigner.VerifyAggregatedSig(pubKeys, []byte("other message"), aggSig)
+			require.NotNil(t, err)
+
+			aggPubKey, err := multiSigner.AggregatePubKeys(pubKeys)
+			require.Nil(t, err)
+			err = multiSigner.VerifyAggregatedSigWithAggPubKey(aggPubKey, msg, aggSig)
+			require.Nil(t, err)
+			require.Equal(t, numSigners, cache.Len())
+			require.Equal(t, uint64(numSigners), cache.Metrics().Misses)
+
+			cachedPubKey, _ := cache.Get(pubKeys[0])
+			require.True(t, signing.IsValidatedPublicKey(cachedPubKey))
+		})
+	}
+}
+
 func TestBLSMultiSigner_CreateSignatureShareNilMessageShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -488,3 +573,232 @@ func Test_ConvertBytesToPrivateKey(t *testing.T) {
 		require.NotNil(t, privKey)
 	})
 }
+
+func TestBLSMultiSigner_AggregatePubKeys(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	hasher := &mock.HasherSpongeMock{}
+	llSigners := map[string]crypto.LowLevelSignerBLS{
+		"with rogue key prevention": &llsig.BlsMultiSigner{Hasher: hasher},
+		"with KOSK":                 &llsig.BlsMultiSignerKOSK{},
+	}
+
+	for name, llSigner := range llSigners {
+		llSigner := llSigner
+		t.Run(name, func(t *testing.T) {
+			multiSigner, pubKeys, sigShares := createSigSharesBLS(10, msg, llSigner)
+			aggSig, err := multiSigner.AggregateSigs(pubKeys, sigShares)
+			require.Nil(t, err)
+
+			pubKeysAggregator := multiSigner.(crypto.PubKeysAggregator)
+			aggPubKey, err := pubKeysAggregator.AggregatePubKeys(pubKeys)
+			require.Nil(t, err)
+			assert.Equal(t, len(pubKeys[0]), len(aggPubKey))
+
+			err = pubKeysAggregator.VerifyAggregatedSigWithAggPubKey(aggPubKey, msg, aggSig)
+			assert.Nil(t, err)
+
+			err = pubKeysAggregator.VerifyAggregatedSigWithAggPubKey(aggPubKey, []byte("other message"), aggSig)
+			assert.Equal(t, crypto.ErrAggSigNotValid, err)
+
+			_, err = pubKeysAggregator.AggregatePubKeys(nil)
+			assert.Equal(t, crypto.ErrNilPublicKeys, err)
+
+			err = pubKeysAggregator.VerifyAggregatedSigWithAggPubKey(nil, msg, aggSig)
+			assert.Equal(t, crypto.ErrEmptyPubKey, err)
+		})
+	}
+}
+
+func TestBLSMultiSigner_AggregatePubKeysNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	// only the crypto.LowLevelSignerBLS methods are promoted from the embedded interface
+	llSigner := struct {
+		crypto.LowLevelSignerBLS
+	}{
+		LowLevelSignerBLS: &llsig.BlsMultiSignerKOSK{},
+	}
+	_, pubKeys, kg := generateMultiSigParamsBLSWithPrivateKeys(2)
+	multiSigner, _ := multisig.NewBLSMultisig(llSigner, kg)
+
+	aggPubKey, err := multiSigner.AggregatePubKeys(pubKeys)
+	assert.Equal(t, crypto.ErrNotImplemented, err)
+	assert.Nil(t, aggPubKey)
+
+	err = multiSigner.VerifyAggregatedSigWithAggPubKey(pubKeys[0], []byte("message"), []byte("signature"))
+	assert.Equal(t, crypto.ErrNotImplemented, err)
+}
+
+func TestBLSMultiSigner_MinPubKeyVariant(t *testing.T) {
+	t.Parallel()
+
+	message := []byte("message")
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12MinPubKey())
+	llSigners := map[string]crypto.LowLevelSignerBLS{
+		"rogue key coefficients": &llsig.BlsMinPubKeyMultiSigner{Hasher: &mock.HasherSpongeMock{}},
+		"KOSK":                   &llsig.BlsMinPubKeyMultiSignerKOSK{},
+	}
+
+	for name, llSigner := range llSigners {
+		t.Run(name, func(t *testing.T) {
+			multiSig, err := multisig.NewBLSMultisig(llSigner, kg)
+			require.Nil(t, err)
+
+			pubKeys := make([][]byte, 0, 5)
+			sigShares := make([][]byte, 0, 5)
+			for i := 0; i < 5; i++ {
+				sk, pk := kg.GeneratePair()
+				skBytes, _ := sk.ToByteArray()
+				pkBytes, _ := pk.ToByteArray()
+				require.Equal(t, 48, len(pkBytes))
+
+				sigShare, errSign := multiSig.CreateSignatureShare(skBytes, message)
+				require.Nil(t, errSign)
+				require.Equal(t, 96, len(sigShare))
+				require.Nil(t, multiSig.VerifySignatureShare(pkBytes, message, sigShare))
+
+				pubKeys = append(pubKeys, pkBytes)
+				sigShares = append(sigShares, sigShare)
+			}
+
+			aggSig, err := multiSig.AggregateSigs(pubKeys, sigShares)
+			require.Nil(t, err)
+			require.Equal(t, 96, len(aggSig))
+			require.Nil(t, multiSig.VerifyAggregatedSig(pubKeys, message, aggSig))
+		})
+	}
+}
+
+func TestBLSMultiSigner_WeightedAggregation(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	hasher := &mock.HasherSpongeMock{}
+	llSigners := map[string]crypto.LowLevelSignerBLS{
+		"with rogue key prevention": &llsig.BlsMultiSigner{Hasher: hasher},
+		"with KOSK":                 &llsig.BlsMultiSignerKOSK{},
+	}
+
+	for name, llSigner := range llSigners {
+		llSigner := llSigner
+		t.Run(name, func(t *testing.T) {
+			multiSigner, pubKeys, sigShares := createSigSharesBLS(4, msg, llSigner)
+			weightedMultiSigner := multiSigner.(crypto.WeightedMultiSigner)
+			weights := []uint64{1500, 2500, 1000, 5000}
+
+			aggSig, err := weightedMultiSigner.AggregateWeightedSigs(pubKeys, sigShares[:3], weights)
+			assert.Nil(t, aggSig)
+			assert.Equal(t, crypto.ErrInvalidParam, err)
+
+			aggSig, err = weightedMultiSigner.AggregateWeightedSigs(pubKeys, sigShares, weights)
+			require.Nil(t, err)
+
+			totalWeight, err := weightedMultiSigner.VerifyWeightedAggregatedSig(pubKeys, weights, msg, aggSig)
+			assert.Nil(t, err)
+			assert.Equal(t, uint64(10000), totalWeight)
+
+			otherWeights := []uint64{1500, 2500, 1000, 5001}
+			totalWeight, err = weightedMultiSigner.VerifyWeightedAggregatedSig(pubKeys, otherWeights, msg, aggSig)
+			assert.Equal(t, crypto.ErrAggSigNotValid, err)
+			assert.Zero(t, totalWeight)
+
+			_, err = weightedMultiSigner.VerifyWeightedAggregatedSig(pubKeys[:3], weights[:3], msg, aggSig)
+			assert.Equal(t, crypto.ErrAggSigNotValid, err)
+		})
+	}
+}
+
+func TestBLSMultiSigner_WeightedAggregationNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	llSigner := struct {
+		crypto.LowLevelSignerBLS
+	}{
+		LowLevelSignerBLS: &llsig.BlsMultiSignerKOSK{},
+	}
+	_, pubKeys, kg := generateMultiSigParamsBLSWithPrivateKeys(2)
+	multiSigner, _ := multisig.NewBLSMultisig(llSigner, kg)
+
+	aggSig, err := multiSigner.AggregateWeightedSigs(pubKeys, [][]byte{[]byte("sig1"), []byte("sig2")}, []uint64{1, 2})
+	assert.Equal(t, crypto.ErrNotImplemented, err)
+	assert.Nil(t, aggSig)
+
+	totalWeight, err := multiSigner.VerifyWeightedAggregatedSig(pubKeys, []uint64{1, 2}, []byte("message"), []byte("signature"))
+	assert.Equal(t, crypto.ErrNotImplemented, err)
+	assert.Zero(t, totalWeight)
+}
+
+func TestBLSMultiSigner_AggregateAndVerifySigs(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	hasher := &mock.HasherSpongeMock{}
+	llSigners := map[string]crypto.LowLevelSignerBLS{
+		"with rogue key prevention": &llsig.BlsMultiSigner{Hasher: hasher},
+		"with KOSK":                 &llsig.BlsMultiSignerKOSK{},
+	}
+
+	for name, llSigner := range llSigners {
+		llSigner := llSigner
+		t.Run(name, func(t *testing.T) {
+			multiSigner, pubKeys, sigShares := createSigSharesBLS(8, msg, llSigner)
+			faultAttributionSigner := multiSigner.(crypto.FaultAttributionMultiSigner)
+
+			aggSig, err := faultAttributionSigner.AggregateAndVerifySigs(pubKeys, sigShares[:7], msg)
+			assert.Nil(t, aggSig)
+			assert.Equal(t, crypto.ErrInvalidParam, err)
+
+			aggSig, err = faultAttributionSigner.AggregateAndVerifySigs(pubKeys, sigShares, msg)
+			require.Nil(t, err)
+			require.Nil(t, multiSigner.VerifyAggregatedSig(pubKeys, msg, aggSig))
+
+			invalidSigShares := make([][]byte, len(sigShares))
+			copy(invalidSigShares, sigShares)
+			invalidSigShares[2] = sigShares[5]
+			invalidSigShares[6] = []byte("invalid signature")
+
+			aggSig, err = faultAttributionSigner.AggregateAndVerifySigs(pubKeys, invalidSigShares, msg)
+			assert.Nil(t, aggSig)
+			assert.True(t, errors.Is(err, crypto.ErrAggSigNotValid))
+
+			invalidSharesErr, ok := err.(*crypto.InvalidSharesError)
+			require.True(t, ok)
+			assert.Equal(t, []int{2, 6}, invalidSharesErr.Indexes)
+		})
+	}
+}
+
+func TestBLSMultiSigner_AggregateAndVerifySigsNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	llSigner := struct {
+		crypto.LowLevelSignerBLS
+	}{
+		LowLevelSignerBLS: &llsig.BlsMultiSignerKOSK{},
+	}
+	_, pubKeys, kg := generateMultiSigParamsBLSWithPrivateKeys(2)
+	multiSigner, _ := multisig.NewBLSMultisig(llSigner, kg)
+
+	aggSig, err := multiSigner.AggregateAndVerifySigs(pubKeys, [][]byte{[]byte("sig1"), []byte("sig2")}, []byte("message"))
+	assert.Equal(t, crypto.ErrNotImplemented, err)
+	assert.Nil(t, aggSig)
+}
+
+func BenchmarkConvertBytesToPubKeys(b *testing.B) {
+	pubKeys, kg := generateMultiSigParamsBLS(400)
+	cache, _ := signing.NewPublicKeysCache(len(pubKeys))
+	cachedKeyGen, _ := signing.NewKeyGeneratorWithPublicKeysCache(kg.Suite(), cache)
+
+	b.Run("without public keys cache", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = multisig.ConvertBytesToPubKeys(pubKeys, kg)
+		}
+	})
+	b.Run("with public keys cache", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = multisig.ConvertBytesToPubKeys(pubKeys, cachedKeyGen)
+		}
+	})
+}
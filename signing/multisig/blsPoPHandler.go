@@ -0,0 +1,59 @@
+package multisig
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+var _ crypto.PoPHandler = (*blsPoPHandler)(nil)
+
+type blsPoPHandler struct {
+	keyGen   crypto.KeyGenerator
+	llSigner crypto.LowLevelPoPSignerBLS
+}
+
+// NewBLSPoPHandler creates a new handler for the BLS proofs of possession
+func NewBLSPoPHandler(
+	llSigner crypto.LowLevelPoPSignerBLS,
+	keyGen crypto.KeyGenerator,
+) (*blsPoPHandler, error) {
+	if check.IfNil(llSigner) {
+		return nil, crypto.ErrNilLowLevelSigner
+	}
+	if check.IfNil(keyGen) {
+		return nil, crypto.ErrNilKeyGenerator
+	}
+	return &blsPoPHandler{
+		keyGen:   keyGen,
+		llSigner: llSigner,
+	}, nil
+}
+
+// CreatePoP returns the proof of possession for the given private key
+func (bph *blsPoPHandler) CreatePoP(privateKeyBytes []byte) ([]byte, error) {
+	privateKey, err := convertBytesToPrivateKey(privateKeyBytes, bph.keyGen)
+	if err != nil {
+		return nil, err
+	}
+
+	return bph.llSigner.CreatePoP(privateKey)
+}
+
+// VerifyPoP verifies the proof of possession of the secret key corresponding to the given public key
+func (bph *blsPoPHandler) VerifyPoP(publicKey []byte, pop []byte) error {
+	if len(pop) == 0 {
+		return crypto.ErrNilSignature
+	}
+
+	pubKey, err := convertBytesToPubKey(publicKey, bph.keyGen)
+	if err != nil {
+		return err
+	}
+
+	return bph.llSigner.VerifyPoP(pubKey, pop)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bph *blsPoPHandler) IsInterfaceNil() bool {
+	return bph == nil
+}
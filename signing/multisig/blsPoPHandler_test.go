@@ -0,0 +1,73 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	llsig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBLSPoPHandler(t *testing.T) {
+	t.Parallel()
+
+	_, kg := generateMultiSigParamsBLS(1)
+
+	t.Run("nil low level signer should err", func(t *testing.T) {
+		popHandler, err := multisig.NewBLSPoPHandler(nil, kg)
+
+		assert.Nil(t, popHandler)
+		assert.Equal(t, crypto.ErrNilLowLevelSigner, err)
+	})
+	t.Run("nil key generator should err", func(t *testing.T) {
+		popHandler, err := multisig.NewBLSPoPHandler(&llsig.BlsMultiSignerKOSK{}, nil)
+
+		assert.Nil(t, popHandler)
+		assert.Equal(t, crypto.ErrNilKeyGenerator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		popHandler, err := multisig.NewBLSPoPHandler(&llsig.BlsMultiSignerKOSK{}, kg)
+
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(popHandler))
+	})
+}
+
+func TestBLSPoPHandler_CreatePoP(t *testing.T) {
+	t.Parallel()
+
+	privKeys, pubKeys, kg := generateMultiSigParamsBLSWithPrivateKeys(1)
+	popHandler, _ := multisig.NewBLSPoPHandler(&llsig.BlsMultiSignerKOSK{}, kg)
+
+	pop, err := popHandler.CreatePoP(nil)
+	assert.Equal(t, crypto.ErrNilPrivateKey, err)
+	assert.Nil(t, pop)
+
+	pop, err = popHandler.CreatePoP(privKeys[0])
+	require.Nil(t, err)
+	assert.Nil(t, popHandler.VerifyPoP(pubKeys[0], pop))
+}
+
+func TestBLSPoPHandler_VerifyPoP(t *testing.T) {
+	t.Parallel()
+
+	privKeys, pubKeys, kg := generateMultiSigParamsBLSWithPrivateKeys(2)
+	popHandler, _ := multisig.NewBLSPoPHandler(&llsig.BlsMultiSignerKOSK{}, kg)
+	pop, err := popHandler.CreatePoP(privKeys[0])
+	require.Nil(t, err)
+
+	err = popHandler.VerifyPoP(pubKeys[0], nil)
+	assert.Equal(t, crypto.ErrNilSignature, err)
+
+	err = popHandler.VerifyPoP(nil, pop)
+	assert.Equal(t, crypto.ErrEmptyPubKey, err)
+
+	err = popHandler.VerifyPoP(pubKeys[1], pop)
+	assert.Equal(t, crypto.ErrPoPNotValid, err)
+
+	err = popHandler.VerifyPoP(pubKeys[0], pop)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,262 @@
+package multisig
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+const (
+	qcNumValidatorsSize = 2
+	qcSigLenSize        = 2
+	qcMsgLenSize        = 4
+	maxQCValidators     = 1<<16 - 1
+	maxQCSigLen         = 1<<16 - 1
+)
+
+// QuorumCertificate holds an aggregated signature over a message, together with the bitmap of the signers from an
+// ordered validator set. The bit for the validator on position i is bitmap[i/8] & (1 << (i%8))
+type QuorumCertificate struct {
+	validators [][]byte
+	bitmap     []byte
+	aggSig     []byte
+	message    []byte
+}
+
+// NewQuorumCertificate creates a new quorum certificate for the ordered validators set. The validators set and the
+// other parameters are copied, so later changes of the caller do not change the certificate
+func NewQuorumCertificate(validators [][]byte, bitmap []byte, aggSig []byte, message []byte) (*QuorumCertificate, error) {
+	if len(validators) == 0 {
+		return nil, crypto.ErrNilPublicKeys
+	}
+	if len(validators) > maxQCValidators {
+		return nil, crypto.ErrInvalidParam
+	}
+	if len(aggSig) == 0 {
+		return nil, crypto.ErrNilSignature
+	}
+	if len(aggSig) > maxQCSigLen {
+		return nil, crypto.ErrInvalidParam
+	}
+	if len(message) == 0 {
+		return nil, crypto.ErrNilMessage
+	}
+	err := checkBitmap(bitmap, len(validators))
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsCopy := make([][]byte, 0, len(validators))
+	for _, validator := range validators {
+		validatorsCopy = append(validatorsCopy, copyBytes(validator))
+	}
+
+	return &QuorumCertificate{
+		validators: validatorsCopy,
+		bitmap:     copyBytes(bitmap),
+		aggSig:     copyBytes(aggSig),
+		message:    copyBytes(message),
+	}, nil
+}
+
+// UnmarshalQuorumCertificate recreates a quorum certificate from its binary form, for the given ordered validators set
+func UnmarshalQuorumCertificate(validators [][]byte, data []byte) (*QuorumCertificate, error) {
+	if len(data) < qcNumValidatorsSize {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	numValidators := int(binary.BigEndian.Uint16(data))
+	if numValidators != len(validators) {
+		return nil, crypto.ErrInvalidParam
+	}
+	data = data[qcNumValidatorsSize:]
+
+	bitmapLen := bitmapSize(numValidators)
+	if len(data) < bitmapLen+qcSigLenSize {
+		return nil, crypto.ErrInvalidParam
+	}
+	bitmap := data[:bitmapLen]
+	data = data[bitmapLen:]
+
+	sigLen := int(binary.BigEndian.Uint16(data))
+	data = data[qcSigLenSize:]
+	if len(data) < sigLen+qcMsgLenSize {
+		return nil, crypto.ErrInvalidParam
+	}
+	aggSig := data[:sigLen]
+	data = data[sigLen:]
+
+	msgLen := binary.BigEndian.Uint32(data)
+	data = data[qcMsgLenSize:]
+	if uint64(len(data)) != uint64(msgLen) {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	return NewQuorumCertificate(validators, bitmap, aggSig, data)
+}
+
+// MarshalBinary returns the compact binary form of the quorum certificate. The validators set is not included,
+// only its size, as it is expected to be known by the receiver:
+// numValidators (2 bytes) | bitmap | len(aggSig) (2 bytes) | aggSig | len(message) (4 bytes) | message
+func (qc *QuorumCertificate) MarshalBinary() ([]byte, error) {
+	size := qcNumValidatorsSize + len(qc.bitmap) + qcSigLenSize + len(qc.aggSig) + qcMsgLenSize + len(qc.message)
+	result := make([]byte, 0, size)
+
+	result = binary.BigEndian.AppendUint16(result, uint16(len(qc.validators)))
+	result = append(result, qc.bitmap...)
+	result = binary.BigEndian.AppendUint16(result, uint16(len(qc.aggSig)))
+	result = append(result, qc.aggSig...)
+	result = binary.BigEndian.AppendUint32(result, uint32(len(qc.message)))
+	result = append(result, qc.message...)
+
+	return result, nil
+}
+
+// Verify checks that the certificate has at least minSigners signers and that the aggregated signature is valid
+func (qc *QuorumCertificate) Verify(verifier crypto.MultiSigVerifier, minSigners int) error {
+	if check.IfNil(verifier) {
+		return crypto.ErrNilMultiSigVerifier
+	}
+	if qc.NumSigners() < minSigners {
+		return crypto.ErrNotEnoughSigners
+	}
+
+	return qc.verifySignature(verifier)
+}
+
+// VerifyWithStake checks that the stake of the signers reaches minStake and that the aggregated signature is valid.
+// stakes[i] holds the stake of the validator on position i
+func (qc *QuorumCertificate) VerifyWithStake(verifier crypto.MultiSigVerifier, stakes []*big.Int, minStake *big.Int) error {
+	if check.IfNil(verifier) {
+		return crypto.ErrNilMultiSigVerifier
+	}
+	if minStake == nil {
+		return crypto.ErrNilParam
+	}
+
+	signersStake, err := qc.SignersStake(stakes)
+	if err != nil {
+		return err
+	}
+	if signersStake.Cmp(minStake) < 0 {
+		return crypto.ErrNotEnoughStake
+	}
+
+	return qc.verifySignature(verifier)
+}
+
+func (qc *QuorumCertificate) verifySignature(verifier crypto.MultiSigVerifier) error {
+	signers := qc.Signers()
+	if len(signers) == 0 {
+		return crypto.ErrNotEnoughSigners
+	}
+
+	return verifier.VerifyAggregatedSig(signers, qc.message, qc.aggSig)
+}
+
+// SignersStake returns the total stake of the signers, where stakes[i] holds the stake of the validator on position i
+func (qc *QuorumCertificate) SignersStake(stakes []*big.Int) (*big.Int, error) {
+	if len(stakes) != len(qc.validators) {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	total := big.NewInt(0)
+	for i, stake := range stakes {
+		if stake == nil || stake.Sign() < 0 {
+			return nil, crypto.ErrInvalidParam
+		}
+		if isBitSet(qc.bitmap, i) {
+			total.Add(total, stake)
+		}
+	}
+
+	return total, nil
+}
+
+// Signers returns copies of the public keys of the signers, in the validators set order
+func (qc *QuorumCertificate) Signers() [][]byte {
+	signers := make([][]byte, 0, qc.NumSigners())
+	for i, validator := range qc.validators {
+		if isBitSet(qc.bitmap, i) {
+			signers = append(signers, copyBytes(validator))
+		}
+	}
+
+	return signers
+}
+
+// NumSigners returns the number of signers marked in the bitmap
+func (qc *QuorumCertificate) NumSigners() int {
+	numSigners := 0
+	for i := range qc.validators {
+		if isBitSet(qc.bitmap, i) {
+			numSigners++
+		}
+	}
+
+	return numSigners
+}
+
+// Bitmap returns a copy of the signers bitmap
+func (qc *QuorumCertificate) Bitmap() []byte {
+	return copyBytes(qc.bitmap)
+}
+
+// AggregatedSignature returns a copy of the aggregated signature
+func (qc *QuorumCertificate) AggregatedSignature() []byte {
+	return copyBytes(qc.aggSig)
+}
+
+// Message returns a copy of the signed message
+func (qc *QuorumCertificate) Message() []byte {
+	return copyBytes(qc.message)
+}
+
+// CreateSignersBitmap creates the bitmap for numValidators validators, with the bits set for the given signer positions
+func CreateSignersBitmap(numValidators int, signersIndexes []int) ([]byte, error) {
+	if numValidators <= 0 || numValidators > maxQCValidators {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	bitmap := make([]byte, bitmapSize(numValidators))
+	for _, idx := range signersIndexes {
+		if idx < 0 || idx >= numValidators {
+			return nil, crypto.ErrIndexOutOfBounds
+		}
+		bitmap[idx/8] |= 1 << (uint(idx) % 8)
+	}
+
+	return bitmap, nil
+}
+
+func checkBitmap(bitmap []byte, numValidators int) error {
+	if len(bitmap) != bitmapSize(numValidators) {
+		return crypto.ErrInvalidBitmap
+	}
+
+	// the bits after the last validator need to be unset
+	for i := numValidators; i < len(bitmap)*8; i++ {
+		if isBitSet(bitmap, i) {
+			return crypto.ErrInvalidBitmap
+		}
+	}
+
+	return nil
+}
+
+func isBitSet(bitmap []byte, idx int) bool {
+	return bitmap[idx/8]&(1<<(uint(idx)%8)) != 0
+}
+
+func bitmapSize(numValidators int) int {
+	return (numValidators + 7) / 8
+}
+
+func copyBytes(buff []byte) []byte {
+	result := make([]byte, len(buff))
+	copy(result, buff)
+
+	return result
+}
@@ -0,0 +1,215 @@
+package multisig_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-crypto-go"
+	llsig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createQuorumCertificate(t *testing.T, numValidators int, signersIndexes []int) (*multisig.QuorumCertificate, crypto.MultiSigner, [][]byte) {
+	msg := []byte("message")
+	privKeys, pubKeys, kg := generateMultiSigParamsBLSWithPrivateKeys(numValidators)
+	multiSigner, _ := multisig.NewBLSMultisig(&llsig.BlsMultiSignerKOSK{}, kg)
+
+	signers := make([][]byte, 0, len(signersIndexes))
+	sigShares := make([][]byte, 0, len(signersIndexes))
+	for _, idx := range signersIndexes {
+		sigShare, err := multiSigner.CreateSignatureShare(privKeys[idx], msg)
+		require.Nil(t, err)
+
+		signers = append(signers, pubKeys[idx])
+		sigShares = append(sigShares, sigShare)
+	}
+
+	aggSig, err := multiSigner.AggregateSigs(signers, sigShares)
+	require.Nil(t, err)
+
+	bitmap, err := multisig.CreateSignersBitmap(numValidators, signersIndexes)
+	require.Nil(t, err)
+
+	qc, err := multisig.NewQuorumCertificate(pubKeys, bitmap, aggSig, msg)
+	require.Nil(t, err)
+
+	return qc, multiSigner, pubKeys
+}
+
+func TestCreateSignersBitmap(t *testing.T) {
+	t.Parallel()
+
+	bitmap, err := multisig.CreateSignersBitmap(0, nil)
+	assert.Equal(t, crypto.ErrInvalidParam, err)
+	assert.Nil(t, bitmap)
+
+	bitmap, err = multisig.CreateSignersBitmap(10, []int{10})
+	assert.Equal(t, crypto.ErrIndexOutOfBounds, err)
+	assert.Nil(t, bitmap)
+
+	bitmap, err = multisig.CreateSignersBitmap(10, []int{0, 3, 8, 9})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0x09, 0x03}, bitmap)
+}
+
+func TestNewQuorumCertificate(t *testing.T) {
+	t.Parallel()
+
+	validators := [][]byte{[]byte("pk0"), []byte("pk1"), []byte("pk2")}
+	aggSig := []byte("signature")
+	msg := []byte("message")
+
+	t.Run("empty validators should err", func(t *testing.T) {
+		qc, err := multisig.NewQuorumCertificate(nil, []byte{1}, aggSig, msg)
+		assert.Equal(t, crypto.ErrNilPublicKeys, err)
+		assert.Nil(t, qc)
+	})
+	t.Run("empty signature should err", func(t *testing.T) {
+		qc, err := multisig.NewQuorumCertificate(validators, []byte{1}, nil, msg)
+		assert.Equal(t, crypto.ErrNilSignature, err)
+		assert.Nil(t, qc)
+	})
+	t.Run("empty message should err", func(t *testing.T) {
+		qc, err := multisig.NewQuorumCertificate(validators, []byte{1}, aggSig, nil)
+		assert.Equal(t, crypto.ErrNilMessage, err)
+		assert.Nil(t, qc)
+	})
+	t.Run("wrong bitmap size should err", func(t *testing.T) {
+		qc, err := multisig.NewQuorumCertificate(validators, []byte{1, 0}, aggSig, msg)
+		assert.Equal(t, crypto.ErrInvalidBitmap, err)
+		assert.Nil(t, qc)
+	})
+	t.Run("bits set outside the validators set should err", func(t *testing.T) {
+		qc, err := multisig.NewQuorumCertificate(validators, []byte{0x09}, aggSig, msg)
+		assert.Equal(t, crypto.ErrInvalidBitmap, err)
+		assert.Nil(t, qc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		qc, err := multisig.NewQuorumCertificate(validators, []byte{0x05}, aggSig, msg)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, qc.NumSigners())
+		assert.Equal(t, [][]byte{validators[0], validators[2]}, qc.Signers())
+		assert.Equal(t, []byte{0x05}, qc.Bitmap())
+		assert.Equal(t, aggSig, qc.AggregatedSignature())
+		assert.Equal(t, msg, qc.Message())
+	})
+	t.Run("later changes of the parameters should not change the certificate", func(t *testing.T) {
+		validatorsCopy := [][]byte{[]byte("pk0"), []byte("pk1"), []byte("pk2")}
+		bitmap := []byte{0x05}
+		qc, err := multisig.NewQuorumCertificate(validatorsCopy, bitmap, []byte("signature"), []byte("message"))
+		require.Nil(t, err)
+
+		validatorsCopy[0] = []byte("pk3")
+		validatorsCopy[2][2] = '4'
+		bitmap[0] = 0x02
+		assert.Equal(t, [][]byte{validators[0], validators[2]}, qc.Signers())
+		assert.Equal(t, []byte{0x05}, qc.Bitmap())
+	})
+	t.Run("changes of the returned values should not change the certificate", func(t *testing.T) {
+		qc, err := multisig.NewQuorumCertificate(validators, []byte{0x05}, aggSig, msg)
+		require.Nil(t, err)
+
+		qc.Signers()[0][2] = '3'
+		qc.Bitmap()[0] = 0x02
+		qc.AggregatedSignature()[0] = 'S'
+		qc.Message()[0] = 'M'
+		assert.Equal(t, [][]byte{validators[0], validators[2]}, qc.Signers())
+		assert.Equal(t, []byte{0x05}, qc.Bitmap())
+		assert.Equal(t, aggSig, qc.AggregatedSignature())
+		assert.Equal(t, msg, qc.Message())
+	})
+}
+
+func TestQuorumCertificate_Verify(t *testing.T) {
+	t.Parallel()
+
+	qc, multiSigner, _ := createQuorumCertificate(t, 10, []int{0, 2, 3, 5, 6, 7, 9})
+
+	err := qc.Verify(nil, 7)
+	assert.Equal(t, crypto.ErrNilMultiSigVerifier, err)
+
+	err = qc.Verify(multiSigner, 8)
+	assert.Equal(t, crypto.ErrNotEnoughSigners, err)
+
+	err = qc.Verify(multiSigner, 7)
+	assert.Nil(t, err)
+}
+
+func TestQuorumCertificate_VerifyInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	qc, multiSigner, pubKeys := createQuorumCertificate(t, 10, []int{0, 2, 3})
+
+	// the same signature with a different bitmap should not verify
+	bitmap, _ := multisig.CreateSignersBitmap(len(pubKeys), []int{0, 2, 4})
+	wrongQC, err := multisig.NewQuorumCertificate(pubKeys, bitmap, qc.AggregatedSignature(), qc.Message())
+	require.Nil(t, err)
+
+	err = wrongQC.Verify(multiSigner, 3)
+	assert.Equal(t, crypto.ErrAggSigNotValid, err)
+}
+
+func TestQuorumCertificate_VerifyWithStake(t *testing.T) {
+	t.Parallel()
+
+	qc, multiSigner, _ := createQuorumCertificate(t, 4, []int{0, 3})
+	stakes := []*big.Int{big.NewInt(10), big.NewInt(20), big.NewInt(30), big.NewInt(40)}
+
+	err := qc.VerifyWithStake(nil, stakes, big.NewInt(50))
+	assert.Equal(t, crypto.ErrNilMultiSigVerifier, err)
+
+	err = qc.VerifyWithStake(multiSigner, stakes, nil)
+	assert.Equal(t, crypto.ErrNilParam, err)
+
+	err = qc.VerifyWithStake(multiSigner, stakes[1:], big.NewInt(50))
+	assert.Equal(t, crypto.ErrInvalidParam, err)
+
+	err = qc.VerifyWithStake(multiSigner, []*big.Int{big.NewInt(10), nil, big.NewInt(30), big.NewInt(40)}, big.NewInt(50))
+	assert.Equal(t, crypto.ErrInvalidParam, err)
+
+	err = qc.VerifyWithStake(multiSigner, stakes, big.NewInt(51))
+	assert.Equal(t, crypto.ErrNotEnoughStake, err)
+
+	err = qc.VerifyWithStake(multiSigner, stakes, big.NewInt(50))
+	assert.Nil(t, err)
+
+	signersStake, err := qc.SignersStake(stakes)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), signersStake)
+}
+
+func TestQuorumCertificate_MarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	qc, multiSigner, pubKeys := createQuorumCertificate(t, 12, []int{1, 2, 4, 8, 11})
+
+	buff, err := qc.MarshalBinary()
+	require.Nil(t, err)
+	assert.Equal(t, 2+2+2+len(qc.AggregatedSignature())+4+len(qc.Message()), len(buff))
+
+	t.Run("different validators set size should err", func(t *testing.T) {
+		recreated, errUnmarshal := multisig.UnmarshalQuorumCertificate(pubKeys[1:], buff)
+		assert.Equal(t, crypto.ErrInvalidParam, errUnmarshal)
+		assert.Nil(t, recreated)
+	})
+	t.Run("truncated data should err", func(t *testing.T) {
+		for i := 0; i < len(buff); i++ {
+			recreated, errUnmarshal := multisig.UnmarshalQuorumCertificate(pubKeys, buff[:i])
+			assert.NotNil(t, errUnmarshal)
+			assert.Nil(t, recreated)
+		}
+	})
+	t.Run("extra data should err", func(t *testing.T) {
+		recreated, errUnmarshal := multisig.UnmarshalQuorumCertificate(pubKeys, append(buff, 0))
+		assert.Equal(t, crypto.ErrInvalidParam, errUnmarshal)
+		assert.Nil(t, recreated)
+	})
+	t.Run("should work", func(t *testing.T) {
+		recreated, errUnmarshal := multisig.UnmarshalQuorumCertificate(pubKeys, buff)
+		require.Nil(t, errUnmarshal)
+		assert.Equal(t, qc, recreated)
+		assert.Nil(t, recreated.Verify(multiSigner, 5))
+	})
+}
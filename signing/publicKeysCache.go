@@ -0,0 +1,151 @@
+package signing
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+)
+
+// PublicKeysCacheMetrics holds the usage counters of a public keys cache
+type PublicKeysCacheMetrics struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Size      int
+}
+
+type publicKeysCacheEntry struct {
+	key    string
+	pubKey crypto.PublicKey
+}
+
+// PublicKeysCache is a bounded, concurrency safe LRU cache of decoded and validated public keys, identified by their
+// byte representation, so the public keys of the consensus group are not decoded and checked again on every message.
+// The key generator puts in the cache only the public keys that passed the validity checks of its suite, marked as
+// validated so that the signers skip those checks. A cache should be used with a single suite, as the entries are not
+// tagged with the suite that decoded them. The public keys created by the key generators are cloned when cached and
+// when returned, so modifying the point of a returned public key does not change the cached one.
+type PublicKeysCache struct {
+	mut        sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+	hits       uint64
+	misses     uint64
+	evictions  uint64
+}
+
+// NewPublicKeysCache creates a public keys cache that holds at most maxEntries public keys
+func NewPublicKeysCache(maxEntries int) (*PublicKeysCache, error) {
+	if maxEntries <= 0 {
+		return nil, crypto.ErrInvalidParam
+	}
+
+	return &PublicKeysCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}, nil
+}
+
+// Get returns the cached public key with the given byte representation
+func (pkc *PublicKeysCache) Get(pubKeyBytes []byte) (crypto.PublicKey, bool) {
+	pkc.mut.Lock()
+	defer pkc.mut.Unlock()
+
+	element, found := pkc.entries[string(pubKeyBytes)]
+	if !found {
+		pkc.misses++
+		return nil, false
+	}
+
+	pkc.hits++
+	pkc.lru.MoveToFront(element)
+
+	return clonePublicKey(element.Value.(*publicKeysCacheEntry).pubKey), true
+}
+
+// Put caches the public key decoded from the given byte representation, evicting the least recently used public
+// keys if the cache is full
+func (pkc *PublicKeysCache) Put(pubKeyBytes []byte, pubKey crypto.PublicKey) {
+	if len(pubKeyBytes) == 0 || pubKey == nil || pubKey.IsInterfaceNil() {
+		return
+	}
+
+	key := string(pubKeyBytes)
+	pubKey = clonePublicKey(pubKey)
+
+	pkc.mut.Lock()
+	defer pkc.mut.Unlock()
+
+	element, found := pkc.entries[key]
+	if found {
+		element.Value.(*publicKeysCacheEntry).pubKey = pubKey
+		pkc.lru.MoveToFront(element)
+		return
+	}
+
+	pkc.entries[key] = pkc.lru.PushFront(&publicKeysCacheEntry{
+		key:    key,
+		pubKey: pubKey,
+	})
+
+	for pkc.lru.Len() > pkc.maxEntries {
+		oldest := pkc.lru.Back()
+		pkc.lru.Remove(oldest)
+		delete(pkc.entries, oldest.Value.(*publicKeysCacheEntry).key)
+		pkc.evictions++
+	}
+}
+
+// Clear removes all the cached public keys. The metrics are kept
+func (pkc *PublicKeysCache) Clear() {
+	pkc.mut.Lock()
+	defer pkc.mut.Unlock()
+
+	pkc.entries = make(map[string]*list.Element)
+	pkc.lru.Init()
+}
+
+// Len returns the number of cached public keys
+func (pkc *PublicKeysCache) Len() int {
+	pkc.mut.Lock()
+	defer pkc.mut.Unlock()
+
+	return pkc.lru.Len()
+}
+
+// Metrics returns the number of hits, misses and evictions since the cache was created, and its current size
+func (pkc *PublicKeysCache) Metrics() PublicKeysCacheMetrics {
+	pkc.mut.Lock()
+	defer pkc.mut.Unlock()
+
+	return PublicKeysCacheMetrics{
+		Hits:      pkc.hits,
+		Misses:    pkc.misses,
+		Evictions: pkc.evictions,
+		Size:      pkc.lru.Len(),
+	}
+}
+
+// clonePublicKey returns a copy of the public keys created by the key generators, with a copy of their point. The
+// other implementations are returned as they are
+func clonePublicKey(pubKey crypto.PublicKey) crypto.PublicKey {
+	pk, ok := pubKey.(*publicKey)
+	if !ok || check.IfNil(pk.pk) {
+		return pubKey
+	}
+
+	return &publicKey{
+		suite:     pk.suite,
+		pk:        pk.pk.Clone(),
+		validated: pk.validated,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pkc *PublicKeysCache) IsInterfaceNil() bool {
+	return pkc == nil
+}
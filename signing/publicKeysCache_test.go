@@ -0,0 +1,174 @@
+package signing_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPublicKeys(numKeys int) ([][]byte, []crypto.PublicKey) {
+	kg := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	pubKeysBytes := make([][]byte, 0, numKeys)
+	pubKeys := make([]crypto.PublicKey, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		_, pubKey := kg.GeneratePair()
+		pubKeyBytes, _ := pubKey.ToByteArray()
+		pubKeysBytes = append(pubKeysBytes, pubKeyBytes)
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	return pubKeysBytes, pubKeys
+}
+
+func TestNewPublicKeysCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := signing.NewPublicKeysCache(0)
+	assert.Equal(t, crypto.ErrInvalidParam, err)
+	assert.True(t, check.IfNil(cache))
+
+	cache, err = signing.NewPublicKeysCache(2)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(cache))
+	assert.Equal(t, 0, cache.Len())
+	assert.Equal(t, signing.PublicKeysCacheMetrics{}, cache.Metrics())
+}
+
+func TestPublicKeysCache_GetPut(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid entries should not be cached", func(t *testing.T) {
+		cache, _ := signing.NewPublicKeysCache(2)
+		pubKeysBytes, pubKeys := createPublicKeys(1)
+
+		cache.Put(nil, pubKeys[0])
+		cache.Put(pubKeysBytes[0], nil)
+		assert.Equal(t, 0, cache.Len())
+	})
+	t.Run("should evict the least recently used public key", func(t *testing.T) {
+		cache, _ := signing.NewPublicKeysCache(2)
+		pubKeysBytes, pubKeys := createPublicKeys(3)
+
+		pubKey, found := cache.Get(pubKeysBytes[0])
+		require.False(t, found)
+		require.Nil(t, pubKey)
+
+		cache.Put(pubKeysBytes[0], pubKeys[0])
+		cache.Put(pubKeysBytes[1], pubKeys[1])
+		pubKey, found = cache.Get(pubKeysBytes[0])
+		require.True(t, found)
+		require.Equal(t, pubKeys[0].Point(), pubKey.Point())
+
+		cache.Put(pubKeysBytes[2], pubKeys[2])
+		require.Equal(t, 2, cache.Len())
+		_, found = cache.Get(pubKeysBytes[1])
+		require.False(t, found)
+		_, found = cache.Get(pubKeysBytes[0])
+		require.True(t, found)
+		_, found = cache.Get(pubKeysBytes[2])
+		require.True(t, found)
+
+		expectedMetrics := signing.PublicKeysCacheMetrics{
+			Hits:      3,
+			Misses:    2,
+			Evictions: 1,
+			Size:      2,
+		}
+		require.Equal(t, expectedMetrics, cache.Metrics())
+	})
+	t.Run("putting twice should keep one entry", func(t *testing.T) {
+		cache, _ := signing.NewPublicKeysCache(2)
+		pubKeysBytes, pubKeys := createPublicKeys(1)
+
+		cache.Put(pubKeysBytes[0], pubKeys[0])
+		cache.Put(pubKeysBytes[0], pubKeys[0])
+		require.Equal(t, 1, cache.Len())
+		require.Equal(t, uint64(0), cache.Metrics().Evictions)
+	})
+	t.Run("modifying the returned public keys should not modify the cached ones", func(t *testing.T) {
+		cache, _ := signing.NewPublicKeysCache(2)
+		kg, _ := signing.NewKeyGeneratorWithPublicKeysCache(mcl.NewSuiteBLS12(), cache)
+		pubKeysBytes, pubKeys := createPublicKeys(2)
+		otherPoint := pubKeys[1].Point().(*mcl.PointG2)
+
+		// the first public key is decoded and cached, the second one is taken from the cache
+		for i := 0; i < 2; i++ {
+			pubKey, err := kg.PublicKeyFromByteArray(pubKeysBytes[0])
+			require.Nil(t, err)
+			require.True(t, signing.IsValidatedPublicKey(pubKey))
+
+			err = pubKey.Point().(*mcl.PointG2).AddAssign(otherPoint)
+			require.Nil(t, err)
+		}
+
+		cachedPubKey, found := cache.Get(pubKeysBytes[0])
+		require.True(t, found)
+		err := cachedPubKey.Point().(*mcl.PointG2).AddAssign(otherPoint)
+		require.Nil(t, err)
+
+		pubKey, err := kg.PublicKeyFromByteArray(pubKeysBytes[0])
+		require.Nil(t, err)
+		pubKeyBytes, _ := pubKey.ToByteArray()
+		require.Equal(t, pubKeysBytes[0], pubKeyBytes)
+	})
+}
+
+func TestPublicKeysCache_Clear(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := signing.NewPublicKeysCache(2)
+	pubKeysBytes, pubKeys := createPublicKeys(2)
+	cache.Put(pubKeysBytes[0], pubKeys[0])
+	cache.Put(pubKeysBytes[1], pubKeys[1])
+	_, _ = cache.Get(pubKeysBytes[0])
+
+	cache.Clear()
+	require.Equal(t, 0, cache.Len())
+	_, found := cache.Get(pubKeysBytes[0])
+	require.False(t, found)
+
+	metrics := cache.Metrics()
+	require.Equal(t, uint64(1), metrics.Hits)
+	require.Equal(t, uint64(1), metrics.Misses)
+	require.Equal(t, 0, metrics.Size)
+}
+
+func TestPublicKeysCache_ConcurrentAccessShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := signing.NewPublicKeysCache(5)
+	pubKeysBytes, pubKeys := createPublicKeys(10)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			idx := i % len(pubKeys)
+			switch i % 4 {
+			case 0:
+				cache.Put(pubKeysBytes[idx], pubKeys[idx])
+			case 1:
+				pubKey, found := cache.Get(pubKeysBytes[idx])
+				if found {
+					assert.Equal(t, pubKeys[idx].Point(), pubKey.Point(), fmt.Sprintf("public key %d", idx))
+				}
+			case 2:
+				_ = cache.Metrics()
+			default:
+				_ = cache.Len()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, cache.Len(), 5)
+}